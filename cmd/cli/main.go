@@ -2,10 +2,14 @@ package main
 
 import (
 	"context"
+	"encoding/hex"
 	"flag"
 	"fmt"
 	"io"
 	"log"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	pb "github.com/gavmckee80/dot1x-grpc/proto"
@@ -16,13 +20,50 @@ func main() {
 	var (
 		serverAddr = flag.String("server", "localhost:50051", "gRPC server address")
 		iface      = flag.String("iface", "eth0", "interface to authenticate")
-		eap        = flag.String("eap", "PEAP", "EAP method (PEAP, TLS, TTLS)")
+		eap        = flag.String("eap", "PEAP", "EAP method (PEAP, TLS, TTLS, FAST, TEAP, PWD, MD5, GTC)")
 		identity   = flag.String("id", "", "EAP identity")
+		anonymous  = flag.String("anon", "", "anonymous outer identity for PEAP/TTLS/FAST/TEAP")
 		password   = flag.String("pass", "", "EAP password (if applicable)")
-		phase2     = flag.String("phase2", "mschapv2", "Inner auth for PEAP/TTLS")
+		phase2     = flag.String("phase2", "", "inner method for PEAP/TTLS/FAST/TEAP (e.g. mschapv2, pap, autheap=gtc; default MSCHAPV2)")
+		caCert     = flag.String("ca", "", "CA certificate file (PEM or DER) verifying the authentication server")
+		caPath     = flag.String("ca-path", "", "directory of trusted CA certificates on the server host")
+		domain     = flag.String("domain-suffix", "", "required domain suffix of the authentication server")
+		clientCert = flag.String("cert", "", "client certificate file (PEM or DER) for TLS")
+		privateKey = flag.String("key", "", "private key file (PEM or DER) for TLS")
+		keyPass    = flag.String("key-pass", "", "password of an encrypted private key")
+		p12        = flag.String("p12", "", "PKCS#12 client identity (.p12/.pfx) for TLS, instead of -cert and -key")
+		p12Pass    = flag.String("p12-pass", "", "password of the PKCS#12 file")
+		fastProv   = flag.Int("fast-prov", 0, "EAP-FAST PAC provisioning: 0 disabled, 1 unauthenticated, 2 authenticated, 3 both")
+		pacFile    = flag.String("pac", "", "EAP-FAST PAC file to import")
+		pacs       = flag.Bool("pacs", false, "list provisioned EAP-FAST PACs")
+		clearPacs  = flag.Bool("clear-pacs", false, "delete the EAP-FAST PAC of the interface")
+		macsec     = flag.String("macsec", "", "MACsec mode: eap (keys derived from EAP) or psk (pre-shared -mka-cak and -mka-ckn, no EAP)")
+		mustSecure = flag.Bool("macsec-must-secure", false, "with -macsec, drop traffic that MKA has not secured")
+		mkaCak     = flag.String("mka-cak", "", "pre-shared MACsec CAK in hex (16 or 32 bytes)")
+		mkaCkn     = flag.String("mka-ckn", "", "MACsec CKN in hex (1 to 32 bytes)")
+		fbEap      = flag.String("fallback-eap", "", "EAP method of a fallback credential set tried when the first is rejected")
+		fbIdentity = flag.String("fallback-id", "", "with -fallback-eap, EAP identity of the fallback")
+		fbPassword = flag.String("fallback-pass", "", "with -fallback-eap, EAP password of the fallback")
+		ssid       = flag.String("ssid", "", "SSID of a WPA2/WPA3-Enterprise wireless network to join")
+		keyMgmt    = flag.String("key-mgmt", "", "with -ssid, comma-separated key management: wpa-eap, sha256, suite-b (default wpa-eap)")
+		pmf        = flag.String("pmf", "", "with -ssid, protected management frames: disabled, optional or required")
+		freqs      = flag.String("freq", "", "with -ssid, comma-separated channel frequencies in MHz to scan")
+		scanSsid   = flag.Bool("scan-ssid", false, "with -ssid, probe for a hidden SSID")
+		driver     = flag.String("driver", "", "wpa_supplicant driver (default wired, nl80211 with -ssid, or macsec_linux with -macsec)")
+		bridge     = flag.String("bridge", "", "bridge or bond the interface is a member of; EAPOL frames are received on it")
+		confFile   = flag.String("config-file", "", "wpa_supplicant configuration file read when the interface is added")
+		eapolVer   = flag.Uint("eapol-version", 0, "EAPOL version 1-3 (default: wpa_supplicant's)")
+		methods    = flag.Bool("methods", false, "list the EAP methods supported by wpa_supplicant")
 		disconnect = flag.Bool("disconnect", false, "disconnect interface")
+		reauth     = flag.Bool("reauth", false, "reauthenticate interface with its current configuration")
+		logoff     = flag.Bool("logoff", false, "send EAPOL-Logoff and stay unauthenticated until -logon")
+		logon      = flag.Bool("logon", false, "end an EAPOL-Logoff and authenticate again")
 		status     = flag.Bool("status", false, "get one-time status of interface")
 		stream     = flag.Bool("stream", false, "stream live status updates")
+		certs      = flag.Bool("certs", false, "list tracked certificates and their expiry")
+		wait       = flag.Bool("wait", false, "configure and wait for the authentication outcome")
+		waitIP     = flag.Bool("wait-ip", false, "with -wait, also wait for an IP address")
+		timeout    = flag.Duration("timeout", 30*time.Second, "with -wait, how long to wait for an outcome")
 	)
 	flag.Parse()
 
@@ -43,13 +84,55 @@ func main() {
 		}
 		fmt.Printf("Disconnect result: %v - %s\n", resp.Success, resp.Message)
 		return
+	case *reauth:
+		resp, err := client.Reauthenticate(ctx, &pb.InterfaceRequest{Interface: *iface})
+		if err != nil {
+			log.Fatalf("Reauthenticate error: %v", err)
+		}
+		fmt.Printf("Reauthenticate result: %v - %s\n", resp.Success, resp.Message)
+		return
+	case *logoff:
+		resp, err := client.EapolLogoff(ctx, &pb.InterfaceRequest{Interface: *iface})
+		if err != nil {
+			log.Fatalf("EapolLogoff error: %v", err)
+		}
+		fmt.Printf("Logoff result: %v - %s\n", resp.Success, resp.Message)
+		return
+	case *logon:
+		resp, err := client.EapolLogon(ctx, &pb.InterfaceRequest{Interface: *iface})
+		if err != nil {
+			log.Fatalf("EapolLogon error: %v", err)
+		}
+		fmt.Printf("Logon result: %v - %s\n", resp.Success, resp.Message)
+		return
 	case *status:
 		resp, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: *iface})
 		if err != nil {
 			log.Fatalf("Status error: %v", err)
 		}
-		fmt.Printf("Status: %s\nEAP: %s\nLast: %s\nTimestamp: %d\n",
-			resp.Status, resp.EapState, resp.LastEvent, resp.Timestamp)
+		fmt.Printf("Status: %s\nEAP: %s\nLast: %s\nIP: %s\nCarrier: %v\nTimestamp: %d\n",
+			resp.Status, resp.EapState, resp.LastEvent, resp.IpAddress, resp.Carrier, resp.Timestamp)
+		if resp.EapType != pb.EapType_EAP_UNKNOWN {
+			fmt.Printf("Method: %s\nIdentity: %s\nAnonymous identity: %s\n", resp.EapType, resp.Identity, resp.AnonymousIdentity)
+		}
+		if o := resp.InterfaceOptions; o != nil {
+			fmt.Printf("Driver: %s\n", o.Driver)
+			if o.BridgeInterface != "" {
+				fmt.Printf("Bridge: %s\n", o.BridgeInterface)
+			}
+		}
+		if ms := resp.Macsec; ms != nil {
+			fmt.Printf("MACsec: %s (driver %s)\nSecure channel: %s %s secured=%v\n",
+				ms.Mode, ms.Driver, ms.Interface, ms.OperState, ms.Secured)
+		}
+		if ws := resp.Wireless; ws != nil {
+			fmt.Printf("SSID: %s\nBSSID: %s\nFrequency: %d MHz\nSignal: %d dBm\nAssociated: %v\n",
+				ws.Ssid, ws.Bssid, ws.Frequency, ws.Signal, ws.Associated)
+		}
+		if cc := resp.CredentialChain; cc != nil {
+			fmt.Printf("Credential set: %d of %d authenticated=%v rejected=%v\n",
+				cc.Active+1, cc.Entries, cc.Authenticated, cc.Failed)
+		}
 		return
 	case *stream:
 		streamCtx, cancel := context.WithCancel(context.Background())
@@ -69,22 +152,150 @@ func main() {
 			}
 			fmt.Printf("[%d] %s - %s (%s)\n",
 				resp.Timestamp, resp.Interface, resp.Status, resp.EapState)
+			if resp.EventDetail != "" {
+				fmt.Printf("    %s: %s\n", resp.LastEvent, resp.EventDetail)
+			}
 		}
 		return
+	case *certs:
+		resp, err := client.ListCertificates(ctx, &pb.ListCertificatesRequest{})
+		if err != nil {
+			log.Fatalf("ListCertificates error: %v", err)
+		}
+		for _, c := range resp.Certificates {
+			flagged := ""
+			if c.Expiring {
+				flagged = " EXPIRING"
+			}
+			fmt.Printf("%s %s (serial %s)\n  issuer: %s\n  expires: %s%s\n  interfaces: %v\n",
+				c.Role, c.Subject, c.Serial, c.Issuer,
+				time.Unix(c.NotAfter, 0).UTC().Format(time.RFC3339), flagged, c.Interfaces)
+		}
+		return
+	case *methods:
+		resp, err := client.ListEapMethods(ctx, &pb.ListEapMethodsRequest{})
+		if err != nil {
+			log.Fatalf("ListEapMethods error: %v", err)
+		}
+		fmt.Printf("Supported: %v\nwpa_supplicant methods: %v\n", resp.Supported, resp.SupplicantMethods)
+		return
+	case *pacs:
+		resp, err := client.ListPacs(ctx, &pb.ListPacsRequest{})
+		if err != nil {
+			log.Fatalf("ListPacs error: %v", err)
+		}
+		for _, p := range resp.Pacs {
+			fmt.Printf("%s: PAC-Type %d from %s (A-ID %s)\n  I-ID: %s\n  updated: %s\n",
+				p.Interface, p.PacType, p.AIdInfo, p.AId, p.IId,
+				time.Unix(p.Modified, 0).UTC().Format(time.RFC3339))
+		}
+		return
+	case *clearPacs:
+		resp, err := client.ClearPacs(ctx, &pb.ClearPacsRequest{Interfaces: []string{*iface}})
+		if err != nil {
+			log.Fatalf("ClearPacs error: %v", err)
+		}
+		fmt.Printf("Cleared PACs: %v\n", resp.Cleared)
+		return
 	}
 
-	eapType := map[string]pb.EapType{
+	eapTypes := map[string]pb.EapType{
 		"PEAP": pb.EapType_EAP_PEAP,
 		"TLS":  pb.EapType_EAP_TLS,
 		"TTLS": pb.EapType_EAP_TTLS,
-	}[(*eap)]
+		"FAST": pb.EapType_EAP_FAST,
+		"TEAP": pb.EapType_EAP_TEAP,
+		"PWD":  pb.EapType_EAP_PWD,
+		"MD5":  pb.EapType_EAP_MD5,
+		"GTC":  pb.EapType_EAP_GTC,
+	}
+	eapType := eapTypes[*eap]
 
 	req := &pb.Dot1XConfigRequest{
-		Interface:  *iface,
-		EapType:    eapType,
-		Identity:   *identity,
-		Password:   *password,
-		Phase2Auth: *phase2,
+		Interface:          *iface,
+		EapType:            eapType,
+		Identity:           *identity,
+		AnonymousIdentity:  *anonymous,
+		Password:           *password,
+		Phase2Auth:         *phase2,
+		CaCert:             readFile(*caCert),
+		ClientCert:         readFile(*clientCert),
+		PrivateKey:         readFile(*privateKey),
+		PrivateKeyPassword: *keyPass,
+		Pkcs12:             readFile(*p12),
+		Pkcs12Password:     *p12Pass,
+		FastProvisioning:   pb.FastProvisioning(*fastProv),
+		Pac:                readFile(*pacFile),
+		CaPath:             *caPath,
+		DomainSuffixMatch:  *domain,
+	}
+	if *fbEap != "" {
+		req.Fallbacks = []*pb.Dot1XConfigRequest{{
+			EapType:  eapTypes[*fbEap],
+			Identity: *fbIdentity,
+			Password: *fbPassword,
+			CaCert:   readFile(*caCert),
+		}}
+	}
+	if *driver != "" || *bridge != "" || *confFile != "" || *eapolVer != 0 {
+		req.InterfaceOptions = &pb.InterfaceOptions{
+			Driver:          *driver,
+			BridgeInterface: *bridge,
+			ConfigFile:      *confFile,
+			EapolVersion:    uint32(*eapolVer),
+		}
+	}
+	switch *macsec {
+	case "":
+	case "eap", "psk":
+		req.Macsec = &pb.MacsecConfig{
+			Mode:       pb.MacsecMode_MACSEC_MODE_EAP,
+			MustSecure: *mustSecure,
+			MkaCak:     readHex(*mkaCak),
+			MkaCkn:     readHex(*mkaCkn),
+		}
+		if *macsec == "psk" {
+			// A pre-shared CAK replaces EAP
+			req.Macsec.Mode = pb.MacsecMode_MACSEC_MODE_PSK
+			req.EapType, req.Identity, req.Password = pb.EapType_EAP_UNKNOWN, "", ""
+		}
+	default:
+		log.Fatalf("Unknown MACsec mode %q (use eap or psk)", *macsec)
+	}
+	if *ssid != "" {
+		req.Wireless = &pb.WirelessConfig{
+			Ssid:        *ssid,
+			KeyMgmt:     parseKeyMgmt(*keyMgmt),
+			Pmf:         parsePMF(*pmf),
+			Frequencies: parseFreqs(*freqs),
+			ScanSsid:    *scanSsid,
+		}
+	}
+
+	if *wait {
+		watchCtx, cancel := context.WithTimeout(context.Background(), *timeout+5*time.Second)
+		defer cancel()
+		stream, err := client.ConfigureAndWatch(watchCtx, &pb.ConfigureAndWatchRequest{
+			Config:         req,
+			TimeoutSeconds: uint32(timeout.Seconds()),
+			WaitForIp:      *waitIP,
+		})
+		if err != nil {
+			log.Fatalf("ConfigureAndWatch error: %v", err)
+		}
+		for {
+			p, err := stream.Recv()
+			if err != nil {
+				log.Fatalf("Error reading progress: %v", err)
+			}
+			fmt.Printf("[%d] %s - %s\n", p.Timestamp, p.Stage, p.Message)
+			if p.Terminal {
+				if !p.Success {
+					os.Exit(1)
+				}
+				return
+			}
+		}
 	}
 
 	resp, err := client.ConfigureInterface(ctx, req)
@@ -92,4 +303,89 @@ func main() {
 		log.Fatalf("Configure error: %v", err)
 	}
 	fmt.Printf("Configure result: %v - %s\n", resp.Success, resp.Message)
+	for _, fe := range resp.FieldErrors {
+		fmt.Printf("  %s: %s\n", fe.Field, fe.Reason)
+	}
+}
+
+// parseKeyMgmt parses a comma-separated list of key management suites.
+func parseKeyMgmt(s string) []pb.KeyManagement {
+	var suites []pb.KeyManagement
+	for _, name := range splitList(s) {
+		switch strings.ToLower(name) {
+		case "wpa-eap":
+			suites = append(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP)
+		case "sha256", "wpa-eap-sha256":
+			suites = append(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP_SHA256)
+		case "suite-b", "wpa-eap-suite-b-192":
+			suites = append(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192)
+		default:
+			log.Fatalf("Unknown key management %q (use wpa-eap, sha256 or suite-b)", name)
+		}
+	}
+	return suites
+}
+
+// parsePMF parses a protected management frame mode.
+func parsePMF(s string) pb.PmfMode {
+	switch strings.ToLower(s) {
+	case "":
+		return pb.PmfMode_PMF_DEFAULT
+	case "disabled":
+		return pb.PmfMode_PMF_DISABLED
+	case "optional":
+		return pb.PmfMode_PMF_OPTIONAL
+	case "required":
+		return pb.PmfMode_PMF_REQUIRED
+	}
+	log.Fatalf("Unknown PMF mode %q (use disabled, optional or required)", s)
+	return pb.PmfMode_PMF_DEFAULT
+}
+
+// parseFreqs parses a comma-separated list of frequencies in MHz.
+func parseFreqs(s string) []uint32 {
+	var freqs []uint32
+	for _, f := range splitList(s) {
+		v, err := strconv.ParseUint(f, 10, 32)
+		if err != nil {
+			log.Fatalf("Invalid frequency %q: %v", f, err)
+		}
+		freqs = append(freqs, uint32(v))
+	}
+	return freqs
+}
+
+// splitList splits a comma-separated flag value, dropping empty items.
+func splitList(s string) []string {
+	var items []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// readHex decodes a hex-encoded key, or returns nil if none is given.
+func readHex(s string) []byte {
+	if s == "" {
+		return nil
+	}
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		log.Fatalf("Invalid hex key: %v", err)
+	}
+	return b
+}
+
+// readFile returns the content of a credential file, or nil if no path is given.
+func readFile(path string) []byte {
+	if path == "" {
+		return nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %v", path, err)
+	}
+	return data
 }
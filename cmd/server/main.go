@@ -6,21 +6,43 @@ package main
 import (
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"strconv"
 	"syscall"
+	"time"
 
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/credentials"
 	grpcapi "github.com/gavmckee80/dot1x-grpc/internal/grpc"
+	"github.com/gavmckee80/dot1x-grpc/internal/metrics"
+	"github.com/gavmckee80/dot1x-grpc/internal/pac"
+	"github.com/gavmckee80/dot1x-grpc/internal/store"
 	pb "github.com/gavmckee80/dot1x-grpc/proto"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
 )
 
+// Defaults, overridden by DOT1X_STATE_DIR, DOT1X_STATE_KEY, DOT1X_CREDENTIAL_DIR,
+// DOT1X_PAC_DIR and DOT1X_METRICS_ADDR
+const (
+	defaultStateDir      = "/var/lib/dot1x/state"  // Persisted desired configuration
+	defaultStateKey      = "/etc/dot1x/state.key"  // Key encrypting the desired configuration
+	defaultCredentialDir = credentials.DefaultRoot // Certificates and keys read by wpa_supplicant
+	defaultPACDir        = "/var/lib/dot1x/pac"    // EAP-FAST PACs provisioned by wpa_supplicant
+	defaultMetricsAddr   = ":9090"                 // Prometheus metrics endpoint
+)
+
 // main initializes and starts the gRPC server for 802.1X authentication management.
 // The server:
 //   - Listens on port 50051 for gRPC connections
+//   - Sweeps credential files orphaned by a previous run
+//   - Restores the persisted desired state of each interface
 //   - Registers the Dot1XManager service
 //   - Enables gRPC reflection for service discovery
+//   - Serves Prometheus metrics on /metrics
 //   - Handles graceful shutdown on SIGINT/SIGTERM signals
 //   - Cleans up resources when shutting down
 func main() {
@@ -30,14 +52,66 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	// Open the desired-state store
+	stateDir := os.Getenv("DOT1X_STATE_DIR")
+	if stateDir == "" {
+		stateDir = defaultStateDir
+	}
+	state, err := store.OpenFileStore(stateDir, stateKeyPath())
+	if err != nil {
+		log.Fatalf("failed to open state store: %v", err)
+	}
+
+	// Open the credential directory, sweeping files orphaned by a previous run
+	credentialDir := os.Getenv("DOT1X_CREDENTIAL_DIR")
+	if credentialDir == "" {
+		credentialDir = defaultCredentialDir
+	}
+	creds, err := credentials.OpenDir(credentialDir)
+	if err != nil {
+		log.Fatalf("failed to open credential directory: %v", err)
+	}
+
+	// Open the EAP-FAST PAC directory, which is kept across restarts
+	pacDir := os.Getenv("DOT1X_PAC_DIR")
+	if pacDir == "" {
+		pacDir = defaultPACDir
+	}
+	pacs, err := pac.OpenStore(pacDir)
+	if err != nil {
+		log.Fatalf("failed to open PAC directory: %v", err)
+	}
+
 	// Initialize gRPC server and register the 802.1X service
 	s := grpc.NewServer()
-	service := grpcapi.NewDot1xService()
+	opts := []core.Option{core.WithStateStore(state), core.WithCredentialDir(creds), core.WithPACDir(pacs)}
+	if days := os.Getenv("DOT1X_CERT_EXPIRY_WARNING_DAYS"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			log.Fatalf("invalid DOT1X_CERT_EXPIRY_WARNING_DAYS %q", days)
+		}
+		opts = append(opts, core.WithExpiryWarning(time.Duration(n)*24*time.Hour))
+	}
+	service := grpcapi.NewDot1xService(opts...)
+	service.Reconcile()
 	pb.RegisterDot1XManagerServer(s, service)
 
 	// Enable gRPC reflection for service discovery and debugging
 	reflection.Register(s)
 
+	// Serve Prometheus metrics
+	metricsAddr := os.Getenv("DOT1X_METRICS_ADDR")
+	if metricsAddr == "" {
+		metricsAddr = defaultMetricsAddr
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler(service))
+	go func() {
+		if err := http.ListenAndServe(metricsAddr, mux); err != nil {
+			log.Printf("[ERROR] Metrics server stopped: %v", err)
+		}
+	}()
+
 	// Set up signal handling for graceful shutdown
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
@@ -55,3 +129,19 @@ func main() {
 	log.Println("gRPC reflection enabled - use grpcurl to explore the API")
 	s.Serve(lis)
 }
+
+// stateKeyPath returns the key file of the desired-state store: DOT1X_STATE_KEY,
+// else the state.key credential systemd passed with LoadCredential=, else the
+// default path.
+func stateKeyPath() string {
+	if path := os.Getenv("DOT1X_STATE_KEY"); path != "" {
+		return path
+	}
+	if dir := os.Getenv("CREDENTIALS_DIRECTORY"); dir != "" {
+		path := filepath.Join(dir, "state.key")
+		if _, err := os.Stat(path); err == nil {
+			return path
+		}
+	}
+	return defaultStateKey
+}
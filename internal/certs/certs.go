@@ -0,0 +1,241 @@
+// Package certs parses and checks the X.509 credentials used for EAP-TLS, so that
+// malformed or mismatched certificates and keys are rejected before they reach
+// wpa_supplicant.
+package certs
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/youmark/pkcs8"
+	"software.sslmate.com/src/go-pkcs12"
+)
+
+// Errors returned when parsing or checking credentials
+var (
+	ErrNoCertificate     = errors.New("no certificate found")
+	ErrNoPrivateKey      = errors.New("no private key found")
+	ErrPasswordRequired  = errors.New("private key is encrypted and no password was given")
+	ErrIncorrectPassword = errors.New("password does not decrypt the private key")
+	ErrNotYetValid       = errors.New("certificate is not yet valid")
+	ErrExpired           = errors.New("certificate has expired")
+	ErrNotClientAuth     = errors.New("certificate is not valid for client authentication")
+	ErrKeyMismatch       = errors.New("private key does not match the certificate")
+	ErrPKCS12Password    = errors.New("password does not decrypt the PKCS#12 bundle")
+)
+
+// ParseCertificates parses one or more certificates in PEM form, or a single
+// certificate in DER form. PEM blocks other than certificates are ignored.
+func ParseCertificates(data []byte) ([]*x509.Certificate, error) {
+	if !isPEM(data) {
+		cert, err := x509.ParseCertificate(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrNoCertificate, err)
+		}
+		return []*x509.Certificate{cert}, nil
+	}
+
+	var certs []*x509.Certificate
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("malformed certificate: %v", err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, ErrNoCertificate
+	}
+	return certs, nil
+}
+
+// ParsePrivateKey parses a private key in PEM or DER form (PKCS#1, PKCS#8 or SEC 1).
+// Encrypted keys (PKCS#8 "ENCRYPTED PRIVATE KEY" or legacy encrypted PEM) are
+// decrypted with password.
+func ParsePrivateKey(data []byte, password string) (crypto.PrivateKey, error) {
+	if !isPEM(data) {
+		if key, err := parseDERKey(data); err == nil {
+			return key, nil
+		}
+		if isEncryptedPKCS8(data) {
+			return parseEncryptedPKCS8(data, password)
+		}
+		return nil, ErrNoPrivateKey
+	}
+
+	for rest := data; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, ErrNoPrivateKey
+		}
+
+		switch {
+		case block.Type == "ENCRYPTED PRIVATE KEY":
+			return parseEncryptedPKCS8(block.Bytes, password)
+
+		case block.Type == "PRIVATE KEY" || block.Type == "RSA PRIVATE KEY" || block.Type == "EC PRIVATE KEY":
+			der := block.Bytes
+			// Legacy PEM encryption is insecure but still produced by OpenSSL 1.x tooling
+			if x509.IsEncryptedPEMBlock(block) {
+				if password == "" {
+					return nil, ErrPasswordRequired
+				}
+				plain, err := x509.DecryptPEMBlock(block, []byte(password))
+				if err != nil {
+					return nil, ErrIncorrectPassword
+				}
+				der = plain
+			}
+			key, err := parseDERKey(der)
+			if err != nil {
+				return nil, fmt.Errorf("malformed private key: %v", err)
+			}
+			return key, nil
+		}
+	}
+}
+
+// DecodePKCS12 unpacks a PKCS#12 client identity, returning its private key and its
+// certificate followed by any intermediate certificates bundled with it.
+func DecodePKCS12(data []byte, password string) (crypto.PrivateKey, []*x509.Certificate, error) {
+	key, cert, chain, err := pkcs12.DecodeChain(data, password)
+	if errors.Is(err, pkcs12.ErrIncorrectPassword) {
+		return nil, nil, ErrPKCS12Password
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("malformed PKCS#12 bundle: %v", err)
+	}
+	return key, append([]*x509.Certificate{cert}, chain...), nil
+}
+
+// EncodeCertificates encodes certificates as concatenated PEM blocks.
+func EncodeCertificates(certs []*x509.Certificate) []byte {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+	}
+	return buf.Bytes()
+}
+
+// EncodePrivateKey encodes an unencrypted private key as a PKCS#8 PEM block.
+func EncodePrivateKey(key crypto.PrivateKey) ([]byte, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// CheckValidity returns ErrNotYetValid or ErrExpired if t is outside the validity
+// period of cert.
+func CheckValidity(cert *x509.Certificate, t time.Time) error {
+	if t.Before(cert.NotBefore) {
+		return fmt.Errorf("%w (valid from %s)", ErrNotYetValid, cert.NotBefore.UTC().Format(time.RFC3339))
+	}
+	if t.After(cert.NotAfter) {
+		return fmt.Errorf("%w (expired %s)", ErrExpired, cert.NotAfter.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
+
+// CheckClientAuth returns ErrNotClientAuth if the extended key usage of cert does
+// not allow TLS client authentication. A certificate without the extension is
+// unrestricted.
+func CheckClientAuth(cert *x509.Certificate) error {
+	if len(cert.ExtKeyUsage) == 0 && len(cert.UnknownExtKeyUsage) == 0 {
+		return nil
+	}
+	for _, usage := range cert.ExtKeyUsage {
+		if usage == x509.ExtKeyUsageClientAuth || usage == x509.ExtKeyUsageAny {
+			return nil
+		}
+	}
+	return ErrNotClientAuth
+}
+
+// CheckKeyPair returns ErrKeyMismatch if key is not the private key of cert.
+func CheckKeyPair(cert *x509.Certificate, key crypto.PrivateKey) error {
+	pub, ok := publicKey(key)
+	if !ok {
+		return fmt.Errorf("unsupported private key type %T", key)
+	}
+	type equaler interface{ Equal(crypto.PublicKey) bool }
+	if eq, ok := pub.(equaler); !ok || !eq.Equal(cert.PublicKey) {
+		return ErrKeyMismatch
+	}
+	return nil
+}
+
+// publicKey returns the public half of a private key.
+func publicKey(key crypto.PrivateKey) (crypto.PublicKey, bool) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return &k.PublicKey, true
+	case *ecdsa.PrivateKey:
+		return &k.PublicKey, true
+	case ed25519.PrivateKey:
+		return k.Public(), true
+	}
+	return nil, false
+}
+
+// parseDERKey parses an unencrypted DER private key in any of the common encodings.
+func parseDERKey(der []byte) (crypto.PrivateKey, error) {
+	if key, err := x509.ParsePKCS8PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(der); err == nil {
+		return key, nil
+	}
+	if key, err := x509.ParseECPrivateKey(der); err == nil {
+		return key, nil
+	}
+	return nil, ErrNoPrivateKey
+}
+
+// parseEncryptedPKCS8 decrypts a DER EncryptedPrivateKeyInfo with password.
+func parseEncryptedPKCS8(der []byte, password string) (crypto.PrivateKey, error) {
+	if password == "" {
+		return nil, ErrPasswordRequired
+	}
+	key, _, err := pkcs8.ParsePrivateKey(der, []byte(password))
+	if err != nil {
+		return nil, ErrIncorrectPassword
+	}
+	return key, nil
+}
+
+// isEncryptedPKCS8 reports whether der has the structure of a PKCS#8
+// EncryptedPrivateKeyInfo.
+func isEncryptedPKCS8(der []byte) bool {
+	var info struct {
+		Algorithm pkix.AlgorithmIdentifier
+		Data      []byte
+	}
+	rest, err := asn1.Unmarshal(der, &info)
+	return err == nil && len(rest) == 0
+}
+
+// isPEM reports whether data looks like PEM rather than DER.
+func isPEM(data []byte) bool {
+	return bytes.Contains(data, []byte("-----BEGIN "))
+}
@@ -0,0 +1,102 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file hands TLS credentials to wpa_supplicant, as in-memory blobs where
+// possible and as files in the credential directory otherwise.
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	godbus "github.com/godbus/dbus/v5"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+)
+
+// credential is a certificate or key referenced by a network field.
+type credential struct {
+	field string // Network field referencing the credential (e.g. "ca_cert")
+	file  string // File name used when falling back to the credential directory
+	data  []byte
+}
+
+// credentialRefs records the blobs and files referenced by a network, which are
+// released together with the network.
+type credentialRefs struct {
+	blobs []string // Blob names on the wpa_supplicant interface
+	files []string // Paths in the credential directory
+}
+
+// storeCredentials makes creds available to wpa_supplicant and points their network
+// fields in cfg at them. Blobs are tried first so that private keys never touch the
+// disk; if wpa_supplicant rejects them, files in the credential directory are used.
+func (m *InterfaceManager) storeCredentials(ifname string, ifacePath godbus.ObjectPath, creds []credential, cfg map[string]string) (credentialRefs, error) {
+	refs, err := m.addBlobs(ifname, ifacePath, creds, cfg)
+	if err == nil {
+		return refs, nil
+	}
+	log.Printf("[WARN] Cannot pass credentials of %s as blobs, using files: %v", ifname, err)
+	return m.writeCredentialFiles(ifname, creds, cfg)
+}
+
+// addBlobs loads creds as blobs on the interface. Blob names are unique per call so
+// the blobs of a new network never replace those of the network it supersedes.
+func (m *InterfaceManager) addBlobs(ifname string, ifacePath godbus.ObjectPath, creds []credential, cfg map[string]string) (credentialRefs, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return credentialRefs{}, fmt.Errorf("failed to generate blob name: %v", err)
+	}
+
+	var refs credentialRefs
+	for _, c := range creds {
+		name := fmt.Sprintf("dot1x-%s-%s", hex.EncodeToString(id), c.field)
+		if err := m.client.AddBlob(ifacePath, name, c.data); err != nil {
+			m.releaseCredentials(ifname, ifacePath, refs)
+			return credentialRefs{}, err
+		}
+		refs.blobs = append(refs.blobs, name)
+		cfg[c.field] = "blob://" + name
+	}
+	return refs, nil
+}
+
+// writeCredentialFiles stores creds in the credential directory of the interface.
+func (m *InterfaceManager) writeCredentialFiles(ifname string, creds []credential, cfg map[string]string) (credentialRefs, error) {
+	if m.creds == nil {
+		return credentialRefs{}, errors.New("credential directory unavailable")
+	}
+
+	var refs credentialRefs
+	for _, c := range creds {
+		path, err := m.creds.Write(ifname, c.file, c.data)
+		if err != nil {
+			removeFiles(refs.files)
+			return credentialRefs{}, err
+		}
+		refs.files = append(refs.files, path)
+		cfg[c.field] = path
+	}
+	return refs, nil
+}
+
+// releaseCredentials removes the blobs and files referenced by a network. Blobs
+// that no longer exist are not an error.
+func (m *InterfaceManager) releaseCredentials(ifname string, ifacePath godbus.ObjectPath, refs credentialRefs) {
+	for _, name := range refs.blobs {
+		err := m.client.RemoveBlob(ifacePath, name)
+		if err != nil && !errors.Is(err, dbus.ErrBlobUnknown) {
+			log.Printf("[WARN] Failed to remove blob %s from %s: %v", name, ifname, err)
+		}
+	}
+	removeFiles(refs.files)
+}
+
+// removeFiles deletes the given files, ignoring files that are already gone.
+func removeFiles(paths []string) {
+	for _, path := range paths {
+		os.Remove(path)
+	}
+}
@@ -0,0 +1,37 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file translates wpa_supplicant EAP signals into the typed events exposed
+// through the gRPC API.
+package core
+
+import (
+	"time"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// eapEventTypes maps the status strings of wpa_supplicant's EAP signal to their enum.
+var eapEventTypes = map[string]pb.EapEventType{
+	"started":                         pb.EapEventType_EAP_EVENT_STARTED,
+	"accept proposed method":          pb.EapEventType_EAP_EVENT_PROPOSED_METHOD_ACCEPTED,
+	"reject proposed method":          pb.EapEventType_EAP_EVENT_PROPOSED_METHOD_REJECTED,
+	"method":                          pb.EapEventType_EAP_EVENT_METHOD_SELECTED,
+	"eap parameter needed":            pb.EapEventType_EAP_EVENT_PARAMETER_NEEDED,
+	"remote certificate verification": pb.EapEventType_EAP_EVENT_REMOTE_CERT_VERIFICATION,
+	"remote TLS alert":                pb.EapEventType_EAP_EVENT_REMOTE_TLS_ALERT,
+	"local TLS alert":                 pb.EapEventType_EAP_EVENT_LOCAL_TLS_ALERT,
+	"completion":                      pb.EapEventType_EAP_EVENT_COMPLETION,
+}
+
+// buildEapEvent converts a wpa_supplicant EAP signal into an EapEvent stamped
+// with the time it was received. Unrecognized statuses map to EAP_EVENT_UNKNOWN
+// but keep their raw status string.
+func buildEapEvent(ifname string, ev dbus.EAPEvent) *pb.EapEvent {
+	return &pb.EapEvent{
+		Interface: ifname,
+		Type:      eapEventTypes[ev.Status],
+		Status:    ev.Status,
+		Parameter: ev.Parameter,
+		Timestamp: time.Now().Unix(),
+	}
+}
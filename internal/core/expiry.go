@@ -0,0 +1,186 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file tracks the certificates handed to wpa_supplicant and warns when they
+// approach expiry.
+package core
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/certs"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// Defaults for certificate expiry tracking
+const (
+	defaultExpiryWindow = 30 * 24 * time.Hour
+	expiryCheckInterval = time.Hour
+)
+
+// certificateWarning reports that a certificate used by an interface is within
+// the expiry window (or has expired).
+type certificateWarning struct {
+	ifname string
+	detail string
+}
+
+// WithExpiryWarning sets how long before its expiry a certificate is reported as
+// expiring. Defaults to 30 days.
+func WithExpiryWarning(window time.Duration) Option {
+	return func(m *InterfaceManager) {
+		m.expiryWindow = window
+	}
+}
+
+// Certificates returns the certificates in the applied configuration of the managed
+// interfaces, including their fallback credential sets, ordered by expiry. A
+// certificate used by several interfaces is listed once with all of them. If
+// ifnames is non-empty, only those interfaces are considered.
+func (m *InterfaceManager) Certificates(ifnames ...string) []*pb.CertificateInfo {
+	wanted := make(map[string]bool, len(ifnames))
+	for _, name := range ifnames {
+		wanted[name] = true
+	}
+
+	m.mu.Lock()
+	configs := make(map[string][]*pb.Dot1XConfigRequest)
+	for ifname, entry := range m.interfaces {
+		if entry.config != nil && (len(wanted) == 0 || wanted[ifname]) {
+			configs[ifname] = entry.sets
+		}
+	}
+	m.mu.Unlock()
+
+	names := make([]string, 0, len(configs))
+	for ifname := range configs {
+		names = append(names, ifname)
+	}
+	sort.Strings(names)
+
+	now := time.Now()
+	var infos []*pb.CertificateInfo
+	byKey := make(map[string]*pb.CertificateInfo)
+	add := func(ifname string, cert *x509.Certificate, role pb.CertificateRole) {
+		sum := sha256.Sum256(cert.Raw)
+		fingerprint := hex.EncodeToString(sum[:])
+		key := fingerprint + "/" + role.String()
+		info, ok := byKey[key]
+		if !ok {
+			info = &pb.CertificateInfo{
+				Subject:           cert.Subject.String(),
+				Issuer:            cert.Issuer.String(),
+				Serial:            cert.SerialNumber.Text(16),
+				NotBefore:         cert.NotBefore.Unix(),
+				NotAfter:          cert.NotAfter.Unix(),
+				Role:              role,
+				FingerprintSha256: fingerprint,
+				Expiring:          now.Add(m.expiryWindow).After(cert.NotAfter),
+			}
+			byKey[key] = info
+			infos = append(infos, info)
+		}
+		// A certificate may appear in several credential sets of an interface
+		if n := len(info.Interfaces); n == 0 || info.Interfaces[n-1] != ifname {
+			info.Interfaces = append(info.Interfaces, ifname)
+		}
+	}
+
+	for _, ifname := range names {
+		for _, cfg := range configs[ifname] {
+			if chain, err := certs.ParseCertificates(cfg.ClientCert); err == nil {
+				add(ifname, chain[0], pb.CertificateRole_CERTIFICATE_ROLE_CLIENT)
+			}
+			if cas, err := certs.ParseCertificates(cfg.CaCert); err == nil {
+				for _, ca := range cas {
+					add(ifname, ca, pb.CertificateRole_CERTIFICATE_ROLE_CA)
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(infos, func(i, j int) bool { return infos[i].NotAfter < infos[j].NotAfter })
+	return infos
+}
+
+// watchExpiry checks the tracked certificates every expiryCheckInterval and after
+// every configuration change, warning once per interface about each certificate
+// that enters the expiry window.
+func (m *InterfaceManager) watchExpiry() {
+	done := make(chan struct{})
+	m.stopExpiry = func() { close(done) }
+
+	go func() {
+		ticker := time.NewTicker(expiryCheckInterval)
+		defer ticker.Stop()
+		warned := make(map[string]bool)
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			case <-m.recheck:
+			}
+			warned = m.checkExpiry(warned)
+		}
+	}()
+}
+
+// requestExpiryCheck asks the expiry watcher to check the certificates again.
+func (m *InterfaceManager) requestExpiryCheck() {
+	select {
+	case m.recheck <- struct{}{}:
+	default:
+	}
+}
+
+// checkExpiry emits a warning for each expiring certificate and interface not in
+// warned, and returns the set of warnings that still apply.
+func (m *InterfaceManager) checkExpiry(warned map[string]bool) map[string]bool {
+	current := make(map[string]bool)
+	for _, cert := range m.Certificates() {
+		if !cert.Expiring {
+			continue
+		}
+		for _, ifname := range cert.Interfaces {
+			key := ifname + "/" + cert.FingerprintSha256
+			current[key] = true
+			if warned[key] {
+				continue
+			}
+			detail := expiryDetail(cert)
+			log.Printf("[WARN] %s: %s", ifname, detail)
+			m.expiry.notify(certificateWarning{ifname: ifname, detail: detail})
+		}
+	}
+	return current
+}
+
+// expiryWarnings returns the details of the expiring certificates of an interface.
+func (m *InterfaceManager) expiryWarnings(ifname string) []string {
+	var details []string
+	for _, cert := range m.Certificates(ifname) {
+		if cert.Expiring {
+			details = append(details, expiryDetail(cert))
+		}
+	}
+	return details
+}
+
+// expiryDetail describes an expiring certificate for operators.
+func expiryDetail(cert *pb.CertificateInfo) string {
+	role := "client"
+	if cert.Role == pb.CertificateRole_CERTIFICATE_ROLE_CA {
+		role = "CA"
+	}
+	notAfter := time.Unix(cert.NotAfter, 0).UTC()
+	verb := "expires"
+	if time.Now().After(notAfter) {
+		verb = "expired"
+	}
+	return fmt.Sprintf("%s certificate %s (serial %s) %s %s", role, cert.Subject, cert.Serial, verb, notAfter.Format(time.RFC3339))
+}
@@ -0,0 +1,222 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file implements fallback credential chains: the ordered credential sets of
+// an interface, each added as a network, and the failover from a rejected set to
+// the next one.
+package core
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"sync"
+
+	godbus "github.com/godbus/dbus/v5"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// credentialSet is one entry of a credential chain: the primary configuration or
+// a fallback merged with the interface settings of the primary.
+type credentialSet struct {
+	req    *pb.Dot1XConfigRequest
+	phase2 pb.Phase2Method // Inner method, resolved during validation
+}
+
+// credentialSets returns the credential chain of a request: the request itself,
+// followed by each fallback with the interface, interface options, MACsec and
+// wireless settings of the request.
+func credentialSets(req *pb.Dot1XConfigRequest) []*credentialSet {
+	sets := []*credentialSet{{req: req}}
+	for _, fb := range req.Fallbacks {
+		set := proto.Clone(fb).(*pb.Dot1XConfigRequest)
+		set.Interface = req.Interface
+		set.InterfaceOptions = req.InterfaceOptions
+		set.Macsec = req.Macsec
+		set.Wireless = req.Wireless
+		sets = append(sets, &credentialSet{req: set})
+	}
+	return sets
+}
+
+// appliedConfig returns the configuration to record for a validated credential
+// chain: the primary request with its fallbacks as applied, so PKCS#12 bundles
+// stay unpacked and imported PACs are not imported again.
+func appliedConfig(sets []*credentialSet) *pb.Dot1XConfigRequest {
+	if len(sets) == 1 {
+		return sets[0].req
+	}
+	applied := proto.Clone(sets[0].req).(*pb.Dot1XConfigRequest)
+	applied.Fallbacks = make([]*pb.Dot1XConfigRequest, len(sets)-1)
+	for i, set := range sets[1:] {
+		fb := proto.Clone(set.req).(*pb.Dot1XConfigRequest)
+		fb.Interface, fb.InterfaceOptions, fb.Macsec, fb.Wireless = "", nil, nil, nil
+		applied.Fallbacks[i] = fb
+	}
+	return applied
+}
+
+// validateFallbacks checks that the fallbacks of a request only carry credentials.
+// The interface settings are those of the primary configuration, and a pre-shared
+// MACsec CAK leaves no EAP to fall back from.
+func validateFallbacks(req *pb.Dot1XConfigRequest) fieldErrors {
+	var errs fieldErrors
+	if len(req.Fallbacks) == 0 {
+		return nil
+	}
+	if macsecMode(req) == pb.MacsecMode_MACSEC_MODE_PSK {
+		errs.add("fallbacks", "a pre-shared MACsec CAK replaces EAP")
+		return errs
+	}
+
+	for i, fb := range req.Fallbacks {
+		inherited := []struct {
+			field string
+			set   bool
+		}{
+			{"interface", fb.Interface != "" && fb.Interface != req.Interface},
+			{"interface_options", fb.InterfaceOptions != nil},
+			{"macsec", fb.Macsec != nil},
+			{"wireless", fb.Wireless != nil},
+		}
+		for _, u := range inherited {
+			if u.set {
+				errs.add(fmt.Sprintf("fallbacks[%d].%s", i, u.field), "is taken from the primary configuration")
+			}
+		}
+		if len(fb.Fallbacks) > 0 {
+			errs.add(fmt.Sprintf("fallbacks[%d].fallbacks", i), "cannot be nested")
+		}
+	}
+	return errs
+}
+
+// fallbackErrors reports the validation failure of fallback i against the fields
+// of fallbacks[i].
+func fallbackErrors(i int, resp *pb.Dot1XConfigResponse, err error) (*pb.Dot1XConfigResponse, error) {
+	prefix := fmt.Sprintf("fallbacks[%d].", i)
+	var invalid *ValidationError
+	if errors.As(err, &invalid) {
+		for _, fe := range invalid.Fields {
+			fe.Field = prefix + fe.Field
+		}
+	}
+	if resp == nil {
+		return nil, err
+	}
+	if len(resp.FieldErrors) == 0 {
+		resp.Message = fmt.Sprintf("Invalid fallback %d: %s", i, resp.Message)
+		return resp, err
+	}
+	for _, fe := range resp.FieldErrors {
+		fe.Field = prefix + fe.Field
+	}
+	return fieldErrors(resp.FieldErrors).response(fmt.Sprintf("Invalid fallback %d", i)), err
+}
+
+// watchFailover follows the EAP outcome on an interface with fallback credential
+// sets. When the selected set is rejected, the network of the next one is
+// selected; once the last one is rejected the interface stays on it. Returns the
+// function that ends the watch.
+func (m *InterfaceManager) watchFailover(ifname string, ifacePath godbus.ObjectPath) (func(), error) {
+	events, cancel, err := m.client.SubscribeEAP(ifacePath)
+	if err != nil {
+		return nil, fmt.Errorf("cannot watch EAP outcome of %s: %v", ifname, err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				if eapEventTypes[ev.Status] == pb.EapEventType_EAP_EVENT_COMPLETION && ev.Parameter != "success" {
+					m.failover(ifname, done)
+				}
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			cancel()
+		})
+	}, nil
+}
+
+// failover records the rejection of the selected credential set of an interface
+// and selects the next one. It does nothing once done is closed, as the networks
+// it watched have been replaced.
+func (m *InterfaceManager) failover(ifname string, done <-chan struct{}) {
+	unlock := m.lockInterface(ifname)
+	defer unlock()
+	select {
+	case <-done:
+		return
+	default:
+	}
+
+	m.mu.Lock()
+	entry, ok := m.interfaces[ifname]
+	if !ok || entry.network() == "" {
+		m.mu.Unlock()
+		return
+	}
+	rejected := entry.active
+	first := !slices.Contains(entry.failed, uint32(rejected))
+	if first {
+		entry.failed = append(entry.failed, uint32(rejected))
+	}
+	if rejected == len(entry.networks)-1 {
+		m.mu.Unlock()
+		if first {
+			log.Printf("[WARN] %s: every credential set was rejected", ifname)
+		}
+		return
+	}
+	entry.active++
+	ifacePath, next, set := entry.path, entry.network(), entry.sets[entry.active]
+	m.mu.Unlock()
+
+	log.Printf("[WARN] %s: credential set %d was rejected; falling back to set %d (EAP-%s, %s)",
+		ifname, rejected, rejected+1, eapMethodName(set.EapType), set.Identity)
+	if err := m.client.SelectNetwork(ifacePath, next); err != nil {
+		log.Printf("[ERROR] Failed to select fallback network of %s: %v", ifname, err)
+	}
+}
+
+// fallbackNetwork returns the network of the credential set that follows set i of
+// an interface, which failover selects once set i is rejected, or "" if set i is
+// the last one.
+func (m *InterfaceManager) fallbackNetwork(ifname string, i int) godbus.ObjectPath {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry, ok := m.interfaces[ifname]; ok && i+1 < len(entry.networks) {
+		return entry.networks[i+1].path
+	}
+	return ""
+}
+
+// chainStatus reports the credential chain of an interface with fallbacks: the
+// selected set, whether it is authenticated and the sets rejected so far. Returns
+// nil without fallbacks. The caller holds m.mu.
+func chainStatus(entry *managedInterface, st *dbus.InterfaceState) *pb.CredentialChainStatus {
+	if len(entry.sets) < 2 {
+		return nil
+	}
+	network := entry.network()
+	return &pb.CredentialChainStatus{
+		Active:        uint32(entry.active),
+		Entries:       uint32(len(entry.sets)),
+		Authenticated: network != "" && st.CurrentNetwork == network && st.State == "completed",
+		Failed:        slices.Clone(entry.failed),
+	}
+}
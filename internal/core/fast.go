@@ -0,0 +1,122 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file configures EAP-FAST and manages the PACs provisioned for it.
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+	"log"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/pac"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// WithPACDir sets the store holding the EAP-FAST PAC file of each interface.
+// PACs are kept across restarts and Disconnect; ClearPacs deletes them. Defaults
+// to a temporary directory removed on Shutdown.
+func WithPACDir(s *pac.Store) Option {
+	return func(m *InterfaceManager) {
+		m.pacs = s
+	}
+}
+
+// validateFAST checks the EAP-FAST settings of a request. A PAC must be
+// available to the interface: imported with the request, provisioned earlier, or
+// provisioned in-line when fast_provisioning allows it.
+func (m *InterfaceManager) validateFAST(req *pb.Dot1XConfigRequest) fieldErrors {
+	var errs fieldErrors
+
+	if req.Password == "" {
+		errs.add("password", "is required")
+	}
+
+	if m.pacs == nil {
+		errs.add("pac", "PAC storage unavailable")
+		return errs
+	}
+	if len(req.Pac) > 0 {
+		if entries, err := pac.Parse(req.Pac); err != nil {
+			errs.add("pac", err.Error())
+		} else if len(entries) == 0 {
+			errs.add("pac", "contains no PAC")
+		}
+	} else if req.FastProvisioning == pb.FastProvisioning_FAST_PROVISIONING_DISABLED && !m.pacs.Exists(req.Interface) {
+		errs.add("fast_provisioning", fmt.Sprintf("is disabled and no PAC is provisioned for %s", req.Interface))
+	}
+	return errs
+}
+
+// applyFAST imports the PAC carried by a request, if any, and adds the provisioning
+// mode and PAC file to cfg. It returns the request to record as applied, without the
+// imported PAC, so a later re-apply does not overwrite a PAC refreshed by the server.
+func (m *InterfaceManager) applyFAST(req *pb.Dot1XConfigRequest, cfg map[string]string) (*pb.Dot1XConfigRequest, error) {
+	path, err := m.pacs.Path(req.Interface)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Pac) > 0 {
+		if err := m.pacs.Import(req.Interface, req.Pac); err != nil {
+			return nil, fmt.Errorf("failed to import PAC: %v", err)
+		}
+		log.Printf("[INFO] Imported PAC for %s", req.Interface)
+		req = proto.Clone(req).(*pb.Dot1XConfigRequest)
+		req.Pac = nil
+	}
+
+	addPhase1(cfg, fmt.Sprintf("fast_provisioning=%d", req.FastProvisioning))
+	cfg["pac_file"] = path
+	return req, nil
+}
+
+// Pacs returns the PACs provisioned for the given interfaces, or for every
+// interface if none are given. Interfaces need not be managed: PACs outlive the
+// configuration that provisioned them.
+func (m *InterfaceManager) Pacs(ifnames ...string) ([]*pb.PacInfo, error) {
+	if m.pacs == nil {
+		return nil, nil
+	}
+	files, err := m.pacs.List(ifnames...)
+	if err != nil {
+		return nil, err
+	}
+
+	var infos []*pb.PacInfo
+	for _, f := range files {
+		for _, e := range f.Entries {
+			infos = append(infos, &pb.PacInfo{
+				Interface: f.Interface,
+				PacType:   uint32(e.Type),
+				AId:       hex.EncodeToString(e.AID),
+				AIdInfo:   e.AIDInfo,
+				IId:       hex.EncodeToString(e.IID),
+				Modified:  f.Modified.Unix(),
+			})
+		}
+	}
+	return infos, nil
+}
+
+// ClearPacs deletes the PACs of the given interfaces, so their next EAP-FAST
+// authentication provisions new ones, and returns the interfaces that had a PAC.
+func (m *InterfaceManager) ClearPacs(ifnames ...string) ([]string, error) {
+	if m.pacs == nil {
+		return nil, nil
+	}
+
+	var cleared []string
+	for _, ifname := range ifnames {
+		unlock := m.lockInterface(ifname)
+		ok, err := m.pacs.Clear(ifname)
+		unlock()
+		if err != nil {
+			return cleared, err
+		}
+		if ok {
+			log.Printf("[INFO] Cleared PAC of %s", ifname)
+			cleared = append(cleared, ifname)
+		}
+	}
+	return cleared, nil
+}
@@ -0,0 +1,186 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file decides how an interface is added to wpa_supplicant: its driver, the
+// bridge it is a port of, its configuration file and its EAPOL settings.
+package core
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	godbus "github.com/godbus/dbus/v5"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// wpa_supplicant drivers used unless a request names one
+const (
+	wiredDriver    = "wired"
+	macsecDriver   = "macsec_linux"
+	wirelessDriver = "nl80211"
+)
+
+// driverPattern matches a wpa_supplicant driver name, or a comma-separated list
+// of names to try in order (e.g. "nl80211,wext").
+var driverPattern = regexp.MustCompile(`^[a-z0-9_]+(,[a-z0-9_]+)*$`)
+
+// maxIfnameLen is the longest Linux interface name (IFNAMSIZ without the NUL).
+const maxIfnameLen = 15
+
+// driverFor returns the default wpa_supplicant driver for a request: nl80211 for
+// wireless networks, macsec_linux for MACsec, as only it handles MKA and the kernel
+// MACsec link, and wired otherwise.
+func driverFor(req *pb.Dot1XConfigRequest) string {
+	switch {
+	case req.GetWireless() != nil:
+		return wirelessDriver
+	case macsecMode(req) != pb.MacsecMode_MACSEC_MODE_DISABLED:
+		return macsecDriver
+	}
+	return wiredDriver
+}
+
+// interfaceOptions returns the wpa_supplicant interface settings a request needs.
+// The driver defaults to the one the network type needs.
+func interfaceOptions(req *pb.Dot1XConfigRequest) dbus.InterfaceOptions {
+	o := req.GetInterfaceOptions()
+	opts := dbus.InterfaceOptions{
+		Driver:       o.GetDriver(),
+		BridgeIfname: o.GetBridgeInterface(),
+		ConfigFile:   o.GetConfigFile(),
+		EapolVersion: o.GetEapolVersion(),
+	}
+	if opts.Driver == "" {
+		opts.Driver = driverFor(req)
+	}
+	if o != nil && o.FastReauth != nil {
+		v := *o.FastReauth
+		opts.FastReauth = &v
+	}
+	if o != nil && o.ApScan != nil {
+		v := *o.ApScan
+		opts.ApScan = &v
+	}
+	return opts
+}
+
+// validateInterfaceOptions checks the interface settings of a request.
+func validateInterfaceOptions(req *pb.Dot1XConfigRequest) fieldErrors {
+	var errs fieldErrors
+	o := req.GetInterfaceOptions()
+	if o == nil {
+		return nil
+	}
+
+	if o.Driver != "" {
+		if !driverPattern.MatchString(o.Driver) {
+			errs.add("interface_options.driver", fmt.Sprintf("%q is not a wpa_supplicant driver name", o.Driver))
+		} else if macsecMode(req) != pb.MacsecMode_MACSEC_MODE_DISABLED && !strings.HasPrefix(o.Driver, "macsec_") {
+			errs.add("interface_options.driver", fmt.Sprintf("%s does not support MACsec (use %s)", o.Driver, macsecDriver))
+		} else if req.GetWireless() != nil && (o.Driver == wiredDriver || strings.HasPrefix(o.Driver, "macsec_")) {
+			errs.add("interface_options.driver", fmt.Sprintf("%s does not support wireless networks (use %s)", o.Driver, wirelessDriver))
+		}
+	}
+	if b := o.BridgeInterface; b != "" {
+		switch {
+		case len(b) > maxIfnameLen || strings.ContainsAny(b, "/: \t") || b == "." || b == "..":
+			errs.add("interface_options.bridge_interface", fmt.Sprintf("%q is not an interface name", b))
+		case b == req.Interface:
+			errs.add("interface_options.bridge_interface", "must differ from interface")
+		}
+	}
+	if o.ConfigFile != "" {
+		if !filepath.IsAbs(o.ConfigFile) {
+			errs.add("interface_options.config_file", "must be an absolute path")
+		} else if info, err := os.Stat(o.ConfigFile); err != nil || !info.Mode().IsRegular() {
+			errs.add("interface_options.config_file", fmt.Sprintf("%s is not a file", o.ConfigFile))
+		}
+	}
+	if o.EapolVersion > 3 {
+		errs.add("interface_options.eapol_version", "must be 1, 2 or 3")
+	}
+	if o.ApScan != nil && *o.ApScan > 2 {
+		errs.add("interface_options.ap_scan", "must be 0, 1 or 2")
+	}
+	return errs
+}
+
+// optionMismatch returns the interface_options field for which an interface
+// wpa_supplicant holds with settings have cannot be used for want, or "" if it can.
+// EAPOL settings left unset in want match any value, as does a driver wpa_supplicant
+// does not report.
+func optionMismatch(have, want dbus.InterfaceOptions) string {
+	switch {
+	case have.Driver != "" && have.Driver != want.Driver:
+		return "driver"
+	case have.BridgeIfname != want.BridgeIfname:
+		return "bridge_interface"
+	case have.ConfigFile != want.ConfigFile:
+		return "config_file"
+	case want.EapolVersion != 0 && have.EapolVersion != want.EapolVersion:
+		return "eapol_version"
+	case want.FastReauth != nil && (have.FastReauth == nil || *have.FastReauth != *want.FastReauth):
+		return "fast_reauth"
+	case want.ApScan != nil && (have.ApScan == nil || *have.ApScan != *want.ApScan):
+		return "ap_scan"
+	}
+	return ""
+}
+
+// ensureInterface returns the wpa_supplicant object path of an interface, adding
+// it with the given options if wpa_supplicant does not know it yet. An interface
+// the manager added with other options is removed and added again; the networks
+// and blobs of the manager went with it. One added by someone else is left alone
+// and reported as an error naming the option that differs.
+func (m *InterfaceManager) ensureInterface(ifname string, opts dbus.InterfaceOptions) (godbus.ObjectPath, error) {
+	ifacePath, err := m.client.GetInterfacePathByName(ifname)
+	if err != nil {
+		return m.createInterface(ifname, opts)
+	}
+	st, err := m.client.GetInterfaceState(ifacePath)
+	if err != nil {
+		return ifacePath, nil
+	}
+	field := optionMismatch(st.Options, opts)
+	if field == "" {
+		return ifacePath, nil
+	}
+	m.mu.Lock()
+	owned := m.created[ifname] == ifacePath
+	m.mu.Unlock()
+	if !owned {
+		return "", fmt.Errorf("%s is held by wpa_supplicant with another interface_options.%s and was not added by this service", ifname, field)
+	}
+
+	log.Printf("[INFO] Re-creating %s in wpa_supplicant with driver %s, bridge %q, config file %q (was %s, %q, %q)",
+		ifname, opts.Driver, opts.BridgeIfname, opts.ConfigFile, st.Options.Driver, st.Options.BridgeIfname, st.Options.ConfigFile)
+	if err := m.client.RemoveInterface(ifacePath); err != nil {
+		return "", fmt.Errorf("failed to remove %s to change its options: %v", ifname, err)
+	}
+	m.mu.Lock()
+	delete(m.created, ifname)
+	stop := func() {}
+	if entry, ok := m.interfaces[ifname]; ok {
+		stop = entry.forget()
+	}
+	m.mu.Unlock()
+	stop()
+	return m.createInterface(ifname, opts)
+}
+
+// createInterface adds an interface to wpa_supplicant and records it as added by
+// the manager.
+func (m *InterfaceManager) createInterface(ifname string, opts dbus.InterfaceOptions) (godbus.ObjectPath, error) {
+	ifacePath, err := m.client.CreateInterface(ifname, opts)
+	if err != nil {
+		return "", err
+	}
+	m.mu.Lock()
+	m.created[ifname] = ifacePath
+	m.mu.Unlock()
+	return ifacePath, nil
+}
@@ -0,0 +1,138 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file follows the lifecycle of wpa_supplicant on the bus and restores the
+// applied configuration when it restarts.
+package core
+
+import (
+	"log"
+)
+
+// supplicantEvent describes a change in the availability of wpa_supplicant.
+type supplicantEvent int
+
+const (
+	// supplicantStopped means wpa_supplicant left the bus; all object paths are stale.
+	supplicantStopped supplicantEvent = iota
+	// supplicantRestarted means wpa_supplicant is back and configuration was re-applied.
+	supplicantRestarted
+)
+
+// String returns the event name used in status updates.
+func (e supplicantEvent) String() string {
+	if e == supplicantStopped {
+		return "supplicant-stopped"
+	}
+	return "supplicant-restarted"
+}
+
+// watchSupplicant follows ownership of the wpa_supplicant bus name. When the
+// owner goes away the cached object paths are invalidated; when a new owner
+// appears the last applied configuration of every interface is re-applied.
+// Watchers are notified of both.
+func (m *InterfaceManager) watchSupplicant() {
+	changes, cancel, err := m.client.SubscribeNameOwnerChanged()
+	if err != nil {
+		log.Printf("[WARN] Cannot watch wpa_supplicant restarts: %v", err)
+		m.stopLifecycle = func() {}
+		return
+	}
+	m.stopLifecycle = cancel
+
+	go func() {
+		for change := range changes {
+			if change.Stopped() {
+				log.Println("[WARN] wpa_supplicant left the bus; invalidating interface state")
+				m.invalidate()
+				m.events.notify(supplicantStopped)
+			}
+			if change.Started() {
+				log.Println("[INFO] wpa_supplicant is on the bus; re-applying configuration")
+				m.reapply()
+				m.events.notify(supplicantRestarted)
+			}
+		}
+	}()
+}
+
+// invalidate forgets the wpa_supplicant objects of every managed interface and
+// deletes the credential files that referenced them. Blobs went away with wpa_supplicant.
+func (m *InterfaceManager) invalidate() {
+	for _, ifname := range m.managedInterfaces() {
+		unlock := m.lockInterface(ifname)
+		m.mu.Lock()
+		stop := func() {}
+		if entry, ok := m.interfaces[ifname]; ok {
+			stop = entry.forget()
+		}
+		m.mu.Unlock()
+		stop()
+		unlock()
+	}
+}
+
+// reapply re-creates the interfaces and networks of the last applied configuration,
+// then restores the desired state of interfaces that are not managed yet, e.g.
+// because wpa_supplicant was not running when Reconcile ran. Interfaces without a
+// configuration (e.g. disconnected ones) are no longer present in wpa_supplicant
+// and stop being managed.
+func (m *InterfaceManager) reapply() {
+	for _, ifname := range m.managedInterfaces() {
+		m.mu.Lock()
+		entry, ok := m.interfaces[ifname]
+		unmanaged := ok && entry.config == nil && entry.path == ""
+		if unmanaged {
+			delete(m.interfaces, ifname)
+		}
+		m.mu.Unlock()
+		if unmanaged && m.creds != nil {
+			if err := m.creds.RemoveInterface(ifname); err != nil {
+				log.Printf("[WARN] %v", err)
+			}
+		}
+		if !ok || entry.config == nil {
+			continue
+		}
+
+		resp, err := m.Configure(entry.config)
+		switch {
+		case err != nil:
+			log.Printf("[ERROR] Failed to re-apply %s: %v", ifname, err)
+		case !resp.Success:
+			log.Printf("[ERROR] Failed to re-apply %s: %s", ifname, resp.Message)
+		default:
+			log.Printf("[INFO] Re-applied %s after wpa_supplicant restart", ifname)
+		}
+	}
+	m.restorePending()
+}
+
+// restorePending configures the interfaces of the desired-state store that have
+// no applied configuration.
+func (m *InterfaceManager) restorePending() {
+	if m.state == nil {
+		return
+	}
+	desired, err := m.state.Load()
+	if err != nil {
+		log.Printf("[WARN] Failed to load desired state: %v", err)
+	}
+	for _, req := range desired {
+		m.mu.Lock()
+		entry, ok := m.interfaces[req.Interface]
+		applied := ok && entry.config != nil
+		m.mu.Unlock()
+		if applied {
+			continue
+		}
+
+		resp, err := m.Configure(req)
+		switch {
+		case err != nil:
+			log.Printf("[ERROR] Failed to restore %s: %v", req.Interface, err)
+		case !resp.Success:
+			log.Printf("[ERROR] Failed to restore %s: %s", req.Interface, resp.Message)
+		default:
+			log.Printf("[INFO] Restored %s (%s) from desired state", req.Interface, req.EapType)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file configures MACsec (IEEE 802.1AE) on top of 802.1X: the MKA settings of
+// a network and the MACsec state reported in status.
+package core
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	"github.com/gavmckee80/dot1x-grpc/internal/netlink"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// macsecMode returns the MACsec mode of a request.
+func macsecMode(req *pb.Dot1XConfigRequest) pb.MacsecMode {
+	return req.GetMacsec().GetMode()
+}
+
+// derivesKeys reports whether an EAP method exports the keying material (MSK)
+// from which MKA derives its CAK.
+func derivesKeys(t pb.EapType) bool {
+	switch t {
+	case pb.EapType_EAP_TLS, pb.EapType_EAP_PEAP, pb.EapType_EAP_TTLS, pb.EapType_EAP_FAST,
+		pb.EapType_EAP_TEAP, pb.EapType_EAP_PWD:
+		return true
+	}
+	return false
+}
+
+// validateMACsec checks the MACsec settings of a request. With MACSEC_MODE_EAP the
+// CAK is derived from the EAP method, which must produce keys; with MACSEC_MODE_PSK
+// a pre-shared CAK and CKN replace EAP altogether.
+func validateMACsec(req *pb.Dot1XConfigRequest) fieldErrors {
+	var errs fieldErrors
+	mc := req.GetMacsec()
+	if mc == nil {
+		return nil
+	}
+
+	switch mc.Mode {
+	case pb.MacsecMode_MACSEC_MODE_DISABLED:
+		if mc.MustSecure || mc.IntegrityOnly || len(mc.MkaCak) > 0 || len(mc.MkaCkn) > 0 || mc.MkaPriority != nil || mc.Port != 0 {
+			errs.add("macsec.mode", "must be MACSEC_MODE_EAP or MACSEC_MODE_PSK to use MACsec settings")
+		}
+		return errs
+
+	case pb.MacsecMode_MACSEC_MODE_EAP:
+		if !derivesKeys(req.EapType) {
+			errs.add("macsec.mode", fmt.Sprintf("EAP-%s derives no keys for MKA", eapMethodName(req.EapType)))
+		}
+		if len(mc.MkaCak) > 0 {
+			errs.add("macsec.mka_cak", "is derived from EAP in MACSEC_MODE_EAP")
+		}
+		if len(mc.MkaCkn) > 0 {
+			errs.add("macsec.mka_ckn", "is derived from EAP in MACSEC_MODE_EAP")
+		}
+
+	case pb.MacsecMode_MACSEC_MODE_PSK:
+		unused := []struct {
+			field string
+			set   bool
+		}{
+			{"eap_type", req.EapType != pb.EapType_EAP_UNKNOWN},
+			{"identity", req.Identity != ""},
+			{"password", req.Password != ""},
+		}
+		for _, u := range unused {
+			if u.set {
+				errs.add(u.field, "is not used with a pre-shared CAK")
+			}
+		}
+		// IEEE 802.1X-2010 allows 128 and 256 bit CAKs and CKNs of up to 32 octets
+		if n := len(mc.MkaCak); n != 16 && n != 32 {
+			errs.add("macsec.mka_cak", fmt.Sprintf("must be 16 or 32 bytes, got %d", n))
+		}
+		if n := len(mc.MkaCkn); n < 1 || n > 32 {
+			errs.add("macsec.mka_ckn", fmt.Sprintf("must be 1 to 32 bytes, got %d", n))
+		}
+
+	default:
+		errs.add("macsec.mode", fmt.Sprintf("unknown mode %d", mc.Mode))
+	}
+
+	if mc.MkaPriority != nil && *mc.MkaPriority > 255 {
+		errs.add("macsec.mka_priority", "must be 0 to 255")
+	}
+	if mc.Port > 65534 {
+		errs.add("macsec.port", "must be 1 to 65534")
+	}
+	return errs
+}
+
+// macsecConfig adds the MACsec policy and MKA settings of a request to cfg.
+func macsecConfig(req *pb.Dot1XConfigRequest, cfg map[string]string) {
+	mc := req.GetMacsec()
+	if mc.GetMode() == pb.MacsecMode_MACSEC_MODE_DISABLED {
+		return
+	}
+
+	// macsec_policy 1 drops unsecured traffic; 0 falls back to it if MKA fails
+	cfg["macsec_policy"] = "0"
+	if mc.MustSecure {
+		cfg["macsec_policy"] = "1"
+	}
+	if mc.IntegrityOnly {
+		cfg["macsec_integ_only"] = "1"
+	}
+	if mc.MkaPriority != nil {
+		cfg["mka_priority"] = strconv.FormatUint(uint64(*mc.MkaPriority), 10)
+	}
+	if mc.Port != 0 {
+		cfg["macsec_port"] = strconv.FormatUint(uint64(mc.Port), 10)
+	}
+	if mc.Mode == pb.MacsecMode_MACSEC_MODE_PSK {
+		cfg["mka_cak"] = hex.EncodeToString(mc.MkaCak)
+		cfg["mka_ckn"] = hex.EncodeToString(mc.MkaCkn)
+	}
+}
+
+// macsecStatus builds the MACsec status of an interface configured for MACsec.
+// wpa_supplicant creates the kernel MACsec link once MKA has set up the secure
+// channel, so the channel is secured while that link is up. Link state is omitted
+// if it cannot be read.
+func (m *InterfaceManager) macsecStatus(mode pb.MacsecMode, st *dbus.InterfaceState, info *netlink.LinkInfo) *pb.MacsecStatus {
+	status := &pb.MacsecStatus{Mode: mode, Driver: st.Options.Driver}
+	if info == nil || info.MACsec == "" {
+		return status
+	}
+	status.Interface = info.MACsec
+	if link, err := m.links.LinkInfo(info.MACsec); err == nil {
+		status.OperState = link.OperState
+		status.Secured = link.OperState == "up"
+	}
+	return status
+}
@@ -4,48 +4,192 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"os"
+	"log"
+	"strconv"
+	"sync"
 	"time"
 
 	godbus "github.com/godbus/dbus/v5"
 
+	"github.com/gavmckee80/dot1x-grpc/internal/credentials"
 	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	"github.com/gavmckee80/dot1x-grpc/internal/netlink"
+	"github.com/gavmckee80/dot1x-grpc/internal/pac"
+	"github.com/gavmckee80/dot1x-grpc/internal/store"
 	pb "github.com/gavmckee80/dot1x-grpc/proto"
 )
 
+// ErrInterfaceNotFound is returned when an interface is not managed by this
+// service or is no longer known to wpa_supplicant.
+var ErrInterfaceNotFound = errors.New("interface not found")
+
+// ErrSupplicantUnavailable is returned when a managed interface cannot be reached
+// because wpa_supplicant is not running or has not been re-populated yet.
+var ErrSupplicantUnavailable = errors.New("wpa_supplicant unavailable")
+
 // InterfaceManager handles 802.1X authentication configuration for network interfaces.
 // It provides methods to configure, monitor, and disconnect interfaces using
 // the underlying D-Bus client to communicate with wpa_supplicant.
+//
+// InterfaceManager is safe for concurrent use. Operations that change the
+// configuration of an interface are serialized per interface, so a Configure and a
+// Disconnect on the same port cannot interleave while different ports proceed in parallel.
 type InterfaceManager struct {
-	client     dbus.SupplicantAPI
-	interfaces map[string]godbus.ObjectPath
-	tempFiles  []string
+	client dbus.SupplicantAPI
+	links  netlink.Provider
+	state  store.Store                   // Desired configuration, nil if persistence is disabled
+	creds  *credentials.Dir              // Credential files referenced by networks
+	pacs   *pac.Store                    // EAP-FAST PAC file of each interface
+	added  listeners[string]             // Names of newly managed interfaces
+	events listeners[supplicantEvent]    // wpa_supplicant stopping or restarting
+	expiry listeners[certificateWarning] // Certificates entering the expiry window
+
+	expiryWindow  time.Duration // How long before expiry a certificate is reported
+	recheck       chan struct{} // Requests an expiry check after a configuration change
+	stopLifecycle func()        // Ends the wpa_supplicant lifecycle watch
+	stopExpiry    func()        // Ends the certificate expiry watch
+
+	mu         sync.Mutex // Guards the fields below
+	interfaces map[string]*managedInterface
+	created    map[string]godbus.ObjectPath // wpa_supplicant interfaces added by the manager
+	ifaceLocks map[string]*ifaceLock
+}
+
+// ifaceLock serializes operations on one interface. It is dropped from
+// InterfaceManager.ifaceLocks once no operation holds or waits for it, so
+// interfaces that are disconnected or never managed leave nothing behind.
+type ifaceLock struct {
+	sync.Mutex
+	refs int // Operations holding or waiting for the lock, guarded by InterfaceManager.mu
+}
+
+// managedInterface records what the manager owns in wpa_supplicant for an interface.
+type managedInterface struct {
+	path      godbus.ObjectPath        // wpa_supplicant interface object, empty while wpa_supplicant is gone
+	networks  []ownedNetwork           // Networks added by the manager, one per credential set
+	active    int                      // Index of the selected credential set
+	failed    []uint32                 // Credential sets rejected since the configuration was applied
+	config    *pb.Dot1XConfigRequest   // Last applied configuration, nil once disconnected
+	sets      []*pb.Dot1XConfigRequest // Credential sets of config in fallback order
+	failover  func()                   // Ends the failover watch, nil without fallbacks
+	loggedOff bool                     // EapolLogoff was sent and not ended by EapolLogon
+}
+
+// ownedNetwork is a network the manager added to wpa_supplicant.
+type ownedNetwork struct {
+	path godbus.ObjectPath // wpa_supplicant network object
+	refs credentialRefs    // Credential blobs and files referenced by the network
+}
+
+// network returns the selected network of the interface, or "" if there is none.
+func (e *managedInterface) network() godbus.ObjectPath {
+	if e.active < len(e.networks) {
+		return e.networks[e.active].path
+	}
+	return ""
+}
+
+// release forgets the networks of the interface and returns them with the function
+// that ends their failover watch, to be called without holding the manager lock.
+func (e *managedInterface) release() ([]ownedNetwork, func()) {
+	networks, stop := e.networks, e.failover
+	e.networks, e.failover = nil, nil
+	if stop == nil {
+		stop = func() {}
+	}
+	return networks, stop
+}
+
+// forget drops the wpa_supplicant objects of the interface once they went away with
+// the interface or wpa_supplicant itself, deleting the credential files of its
+// networks. Returns the function that ends the failover watch, as release does.
+func (e *managedInterface) forget() func() {
+	networks, stop := e.release()
+	for _, n := range networks {
+		removeFiles(n.refs.files)
+	}
+	e.path = ""
+	return stop
+}
+
+// Option configures optional dependencies of an InterfaceManager.
+type Option func(*InterfaceManager)
+
+// WithLinkInfoProvider sets the provider used to read kernel link state
+// (addresses, carrier, MAC). Defaults to rtnetlink.
+func WithLinkInfoProvider(p netlink.Provider) Option {
+	return func(m *InterfaceManager) {
+		m.links = p
+	}
+}
+
+// WithStateStore sets the store used to persist the desired configuration of each
+// interface, which Reconcile re-applies after a restart. Persistence is disabled
+// if no store is set.
+func WithStateStore(s store.Store) Option {
+	return func(m *InterfaceManager) {
+		m.state = s
+	}
+}
+
+// WithCredentialDir sets the directory where certificates and private keys are
+// written for wpa_supplicant. Files left in it by a previous run are deleted when
+// it is opened. Defaults to a private temporary directory removed on Shutdown.
+func WithCredentialDir(d *credentials.Dir) Option {
+	return func(m *InterfaceManager) {
+		m.creds = d
+	}
 }
 
 // NewInterfaceManager creates a new InterfaceManager instance with a default
 // D-Bus client connection to wpa_supplicant.
 //
 // Returns an error if the D-Bus connection cannot be established.
-func NewInterfaceManager() (*InterfaceManager, error) {
+func NewInterfaceManager(opts ...Option) (*InterfaceManager, error) {
 	client, err := dbus.NewSupplicantClient()
 	if err != nil {
 		return nil, err
 	}
-	return &InterfaceManager{
-		client:     client,
-		interfaces: make(map[string]godbus.ObjectPath),
-	}, nil
+	return NewInterfaceManagerWithClient(client, opts...), nil
 }
 
 // NewInterfaceManagerWithClient creates a new InterfaceManager instance with
 // a custom D-Bus client. This is primarily used for testing with mock clients.
-func NewInterfaceManagerWithClient(c dbus.SupplicantAPI) *InterfaceManager {
-	return &InterfaceManager{
-		client:     c,
-		interfaces: make(map[string]godbus.ObjectPath),
+func NewInterfaceManagerWithClient(c dbus.SupplicantAPI, opts ...Option) *InterfaceManager {
+	m := &InterfaceManager{
+		client:       c,
+		links:        netlink.NewRTNetlink(),
+		expiryWindow: defaultExpiryWindow,
+		recheck:      make(chan struct{}, 1),
+		interfaces:   make(map[string]*managedInterface),
+		created:      make(map[string]godbus.ObjectPath),
+		ifaceLocks:   make(map[string]*ifaceLock),
+	}
+	for _, opt := range opts {
+		opt(m)
 	}
+
+	if m.creds == nil {
+		creds, err := credentials.OpenTempDir()
+		if err != nil {
+			log.Printf("[ERROR] %v", err)
+		}
+		m.creds = creds
+	}
+	if m.pacs == nil {
+		pacs, err := pac.OpenTempStore()
+		if err != nil {
+			log.Printf("[ERROR] %v", err)
+		}
+		m.pacs = pacs
+	}
+
+	m.watchSupplicant()
+	m.watchExpiry()
+	return m
 }
 
 // Configure sets up 802.1X authentication for a network interface based on
@@ -53,135 +197,735 @@ func NewInterfaceManagerWithClient(c dbus.SupplicantAPI) *InterfaceManager {
 //
 // The method validates the request parameters and handles different EAP types:
 //   - EAP-PEAP/TTLS: Requires identity, password, and phase2 authentication
-//   - EAP-TLS: Requires CA certificate, client certificate, and private key
+//   - EAP-TLS: Requires a valid CA certificate, client certificate and matching
+//     private key, or a PKCS#12 bundle in place of the last two; each problem is
+//     reported against its field in FieldErrors
+//   - EAP-FAST: Requires identity and password, and a PAC that is imported with
+//     the request, was provisioned earlier, or may be provisioned in-line
+//   - EAP-TEAP: Requires identity and password, with MSCHAPV2 (default) or GTC
+//     as inner method and an optional CA certificate
+//   - EAP-PWD, EAP-MD5, EAP-GTC: Require identity and password only
+//
+// The interface is added to wpa_supplicant with the driver, bridge, configuration
+// file and EAPOL settings of interface_options, and re-created if the manager added
+// it with different ones; an interface added by someone else with different ones is
+// not touched and the configuration fails. The driver defaults to wired, nl80211 for wireless networks, or
+// macsec_linux with MACsec.
+// MACSEC_MODE_EAP derives the MKA keys from the EAP method; MACSEC_MODE_PSK uses a
+// pre-shared CAK and CKN instead of EAP, so no EAP type or identity is needed.
+// With wireless set the network is a WPA2- or WPA3-Enterprise network on the
+// nl80211 driver, with WPA-EAP key management unless other suites are given.
+//
+// Methods that the local wpa_supplicant was built without are rejected. The inner
+// method of the tunneled methods is taken from phase2 (or the legacy phase2_auth)
+// and defaults to MSCHAPV2; an inner method the outer method does not support is
+// returned as a ValidationError matching ErrInvalidArgument.
+//
+// Each of fallbacks is an alternative EAP method and credentials for the same
+// interface, validated like the request itself with its problems reported against
+// fallbacks[i]. All of them are added as networks of decreasing priority and the
+// primary one is selected; when EAP fails on the selected network, the next one is
+// selected in its place.
 //
 // Returns a Dot1XConfigResponse indicating success or failure with details.
 func (m *InterfaceManager) Configure(req *pb.Dot1XConfigRequest) (*pb.Dot1XConfigResponse, error) {
-	// Validate EAP type
-	if req.EapType == pb.EapType_EAP_UNKNOWN {
-		return &pb.Dot1XConfigResponse{Success: false, Message: "Invalid EAP type"}, nil
-	}
+	return m.configure(req, nil)
+}
 
-	// Validate required identity
-	if req.Identity == "" {
-		return &pb.Dot1XConfigResponse{Success: false, Message: "Identity is required"}, nil
+// configure implements Configure. If beforeSelect is non-nil it is called once the
+// network has been added to wpa_supplicant and before it is selected, so callers can
+// subscribe to signals without missing the start of the authentication.
+func (m *InterfaceManager) configure(req *pb.Dot1XConfigRequest, beforeSelect func(ifacePath godbus.ObjectPath) error) (*pb.Dot1XConfigResponse, error) {
+	// Fallbacks take the interface settings from the primary configuration
+	if errs := validateFallbacks(req); len(errs) > 0 {
+		return errs.response("Invalid fallback credentials"), nil
 	}
 
-	// Validate TLS credentials for EAP-TLS
-	if req.EapType == pb.EapType_EAP_TLS {
-		if len(req.CaCert) == 0 || len(req.ClientCert) == 0 || len(req.PrivateKey) == 0 {
-			return &pb.Dot1XConfigResponse{Success: false, Message: "TLS credentials missing"}, nil
+	// Validate the primary configuration and each fallback credential set
+	sets := credentialSets(req)
+	for i, set := range sets {
+		resp, err := m.validate(set)
+		if i > 0 {
+			resp, err = fallbackErrors(i-1, resp, err)
+		}
+		if resp != nil || err != nil {
+			return resp, err
 		}
 	}
+	req = sets[0].req
+
+	// Serialize with other operations on the same interface
+	unlock := m.lockInterface(req.Interface)
+	defer unlock()
 
-	// Get or create interface path
-	ifacePath, err := m.client.GetInterfacePathByName(req.Interface)
+	// Get or create interface path, with the driver and options the configuration needs
+	ifacePath, err := m.ensureInterface(req.Interface, interfaceOptions(req))
 	if err != nil {
-		ifacePath, err = m.client.CreateInterface(req.Interface)
-		if err != nil {
+		return &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	// Add a network for each credential set, the primary one with the highest priority
+	networks := make([]ownedNetwork, 0, len(sets))
+	discard := func() {
+		for _, n := range networks {
+			m.discardNetwork(req.Interface, ifacePath, n.path, n.refs)
+		}
+	}
+	for i, set := range sets {
+		priority := 0
+		if len(sets) > 1 {
+			priority = len(sets) - i
+		}
+		n, resp, err := m.addNetwork(ifacePath, set, priority)
+		if resp != nil || err != nil {
+			discard()
+			return resp, err
+		}
+		networks = append(networks, n)
+	}
+
+	// Follow the outcome of the primary network to fall back from it
+	var failover func()
+	if len(networks) > 1 {
+		if failover, err = m.watchFailover(req.Interface, ifacePath); err != nil {
+			discard()
 			return &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
 		}
 	}
-	m.interfaces[req.Interface] = ifacePath
+	stopFailover := func() {
+		if failover != nil {
+			failover()
+		}
+	}
 
-	// Build wpa_supplicant configuration
-	cfg := map[string]string{
-		"eap":         req.EapType.String()[4:], // Strip EAP_ prefix
-		"identity":    req.Identity,
-		"key_mgmt":    "IEEE8021X",
-		"eapol_flags": "0",
+	if beforeSelect != nil {
+		if err := beforeSelect(ifacePath); err != nil {
+			stopFailover()
+			discard()
+			return nil, err
+		}
 	}
 
-	// Add password and phase2 auth for PEAP/TTLS
-	if req.EapType == pb.EapType_EAP_PEAP || req.EapType == pb.EapType_EAP_TTLS {
-		cfg["password"] = req.Password
-		cfg["phase2"] = fmt.Sprintf("auth=%s", req.Phase2Auth)
+	// A logged off interface stays so, even on an interface object wpa_supplicant
+	// has not sent EAPOL-Logoff on yet
+	m.mu.Lock()
+	loggedOff := m.interfaces[req.Interface] != nil && m.interfaces[req.Interface].loggedOff
+	m.mu.Unlock()
+	if loggedOff {
+		if err := m.client.EAPLogoff(ifacePath); err != nil {
+			log.Printf("[WARN] Failed to keep %s logged off: %v", req.Interface, err)
+		}
 	}
 
-	// Handle TLS certificate files for EAP-TLS
-	if req.EapType == pb.EapType_EAP_TLS {
-		caPath, err := writeTempFile(req.CaCert, "ca.pem")
-		if err != nil {
-			return nil, err
+	// Select the primary network
+	err = m.client.SelectNetwork(ifacePath, networks[0].path)
+	if err != nil {
+		stopFailover()
+		discard()
+		return &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
+	}
+
+	// The new networks replace those previously owned on this interface, which only
+	// becomes managed now that they are selected
+	applied := appliedConfig(sets)
+	m.mu.Lock()
+	entry, known := m.interfaces[req.Interface]
+	if !known {
+		entry = &managedInterface{}
+		m.interfaces[req.Interface] = entry
+	}
+	entry.path = ifacePath
+	old, stopOld := entry.release()
+	entry.networks, entry.active, entry.failed, entry.failover = networks, 0, nil, failover
+	entry.config, entry.sets = applied, make([]*pb.Dot1XConfigRequest, len(sets))
+	for i, set := range sets {
+		entry.sets[i] = set.req
+	}
+	m.mu.Unlock()
+	stopOld()
+	for _, n := range old {
+		m.discardNetwork(req.Interface, ifacePath, n.path, n.refs)
+	}
+	if !known {
+		m.added.notify(req.Interface)
+	}
+
+	m.requestExpiryCheck()
+
+	// Record the configuration as the desired state of the interface
+	if m.state != nil {
+		if err := m.state.Save(applied); err != nil {
+			log.Printf("[ERROR] Failed to persist desired state of %s: %v", req.Interface, err)
+			return &pb.Dot1XConfigResponse{Success: true, Message: "Configured (desired state not persisted: " + err.Error() + ")"}, nil
 		}
-		clientCert, err := writeTempFile(req.ClientCert, "client.pem")
-		if err != nil {
-			return nil, err
+	}
+
+	return &pb.Dot1XConfigResponse{Success: true, Message: "Configured"}, nil
+}
+
+// validate checks a credential set before anything is changed in wpa_supplicant.
+// On success set holds the request to apply (see validateTLSCredentials) and its
+// inner method; otherwise the failure response or invalid argument error is returned.
+func (m *InterfaceManager) validate(set *credentialSet) (*pb.Dot1XConfigResponse, error) {
+	req := set.req
+
+	// Check how the interface is to be added to wpa_supplicant and, for a wireless
+	// network, how it is secured
+	if errs := validateInterfaceOptions(req); len(errs) > 0 {
+		return errs.response("Invalid interface options"), nil
+	}
+	if errs := validateWireless(req); len(errs) > 0 {
+		return errs.response("Invalid wireless configuration"), nil
+	}
+
+	// A pre-shared MACsec CAK replaces EAP; only the MKA settings apply
+	psk := macsecMode(req) == pb.MacsecMode_MACSEC_MODE_PSK
+	if psk {
+		if errs := validateMACsec(req); len(errs) > 0 {
+			return errs.response("Invalid MACsec configuration"), nil
 		}
-		privateKey, err := writeTempFile(req.PrivateKey, "key.pem")
-		if err != nil {
+	}
+
+	// Validate EAP type
+	if req.EapType == pb.EapType_EAP_UNKNOWN && !psk {
+		return &pb.Dot1XConfigResponse{Success: false, Message: "Invalid EAP type"}, nil
+	}
+
+	// Validate required identity
+	if req.Identity == "" && !psk {
+		return &pb.Dot1XConfigResponse{Success: false, Message: "Identity is required"}, nil
+	}
+
+	if !psk {
+		// Check that wpa_supplicant supports the method, the fields it needs, how
+		// the authentication server is verified and whether MKA can use its keys
+		errs := m.validateEAPMethod(req)
+		errs = append(errs, validateServerTrust(req, time.Now())...)
+		errs = append(errs, validateMACsec(req)...)
+		if len(errs) > 0 {
+			return errs.response("Invalid EAP-" + eapMethodName(req.EapType) + " configuration"), nil
+		}
+
+		// Resolve the inner method; one the outer method cannot run is an invalid argument
+		var err error
+		if set.phase2, err = resolvePhase2(req); err != nil {
 			return nil, err
 		}
-		m.tempFiles = append(m.tempFiles, caPath, clientCert, privateKey)
+	}
+
+	// Validate TLS credentials for EAP-TLS, unpacking a PKCS#12 identity
+	if req.EapType == pb.EapType_EAP_TLS {
+		var errs fieldErrors
+		if set.req, errs = validateTLSCredentials(req, time.Now()); len(errs) > 0 {
+			return errs.response("Invalid TLS credentials"), nil
+		}
+	}
+	if req.EapType == pb.EapType_EAP_FAST {
+		if errs := m.validateFAST(req); len(errs) > 0 {
+			return errs.response("Invalid EAP-FAST configuration"), nil
+		}
+	}
+	return nil, nil
+}
+
+// addNetwork adds the network of a validated credential set to wpa_supplicant,
+// with its credentials. A priority of 0 leaves the network priority unset. The
+// request of set is updated if applying it consumed a field (see applyFAST).
+func (m *InterfaceManager) addNetwork(ifacePath godbus.ObjectPath, set *credentialSet, priority int) (ownedNetwork, *pb.Dot1XConfigResponse, error) {
+	req := set.req
+	psk := macsecMode(req) == pb.MacsecMode_MACSEC_MODE_PSK
+
+	// Build wpa_supplicant configuration
+	cfg := map[string]string{"key_mgmt": "NONE"}
+	if !psk {
+		cfg = map[string]string{
+			"eap":         eapMethodName(req.EapType),
+			"identity":    req.Identity,
+			"key_mgmt":    "IEEE8021X",
+			"eapol_flags": "0",
+		}
+
+		// Add the anonymous identity, inner method and password
+		methodConfig(req, set.phase2, cfg)
+	}
+	if priority > 0 {
+		cfg["priority"] = strconv.Itoa(priority)
+	}
+
+	// Add the MACsec policy and MKA settings, or the settings of a wireless network
+	macsecConfig(req, cfg)
+	wirelessConfig(req, cfg)
+
+	// Add provisioning mode and PAC file for EAP-FAST
+	var err error
+	if req.EapType == pb.EapType_EAP_FAST {
+		if req, err = m.applyFAST(req, cfg); err != nil {
+			return ownedNetwork{}, &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
+		}
+		set.req = req
+	}
 
-		cfg["ca_cert"] = caPath
-		cfg["client_cert"] = clientCert
-		cfg["private_key"] = privateKey
+	// Add how the authentication server is verified
+	serverTrustConfig(req, cfg)
 
+	// Hand the CA certificates, and the client credentials for EAP-TLS, to wpa_supplicant
+	var creds []credential
+	if len(req.CaCert) > 0 {
+		creds = append(creds, credential{field: "ca_cert", file: "ca.pem", data: req.CaCert})
+	}
+	if req.EapType == pb.EapType_EAP_TLS {
+		creds = append(creds,
+			credential{field: "client_cert", file: "client.pem", data: req.ClientCert},
+			credential{field: "private_key", file: "key.pem", data: req.PrivateKey},
+		)
 		if req.PrivateKeyPassword != "" {
 			cfg["private_key_passwd"] = req.PrivateKeyPassword
 		}
 	}
+	var refs credentialRefs
+	if len(creds) > 0 {
+		if refs, err = m.storeCredentials(req.Interface, ifacePath, creds, cfg); err != nil {
+			return ownedNetwork{}, nil, err
+		}
+	}
 
 	// Add network configuration to wpa_supplicant
 	netPath, err := m.client.AddNetwork(ifacePath, cfg)
 	if err != nil {
-		return &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
-	}
-
-	// Select the configured network
-	err = m.client.SelectNetwork(ifacePath, netPath)
-	if err != nil {
-		return &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
+		m.releaseCredentials(req.Interface, ifacePath, refs)
+		return ownedNetwork{}, &pb.Dot1XConfigResponse{Success: false, Message: err.Error()}, nil
 	}
-
-	return &pb.Dot1XConfigResponse{Success: true, Message: "Configured"}, nil
+	return ownedNetwork{path: netPath, refs: refs}, nil, nil
 }
 
 // Disconnect terminates the 802.1X authentication session for the specified interface.
-// It removes the interface from the managed interfaces list and disconnects
-// the network in wpa_supplicant.
+// It disconnects the network in wpa_supplicant, removes the network and its
+// credential blobs and files, and removes the interface's desired state, so the
+// session is not restored when the service restarts.
 //
 // Returns a DisconnectResponse indicating success or failure.
 func (m *InterfaceManager) Disconnect(req *pb.InterfaceRequest) (*pb.DisconnectResponse, error) {
-	ifacePath, ok := m.interfaces[req.Interface]
-	if !ok {
+	unlock := m.lockInterface(req.Interface)
+	defer unlock()
+
+	ifacePath, err := m.lookup(req.Interface)
+	if err != nil {
 		return &pb.DisconnectResponse{Success: false, Message: "Interface not managed"}, nil
 	}
 
-	err := m.client.DisconnectNetwork(ifacePath)
+	err = m.client.DisconnectNetwork(ifacePath)
 	if err != nil {
 		return &pb.DisconnectResponse{Success: false, Message: err.Error()}, nil
 	}
 
+	m.mu.Lock()
+	entry := m.interfaces[req.Interface]
+	networks, stop := entry.release()
+	entry.config, entry.sets = nil, nil
+	m.mu.Unlock()
+	stop()
+	for _, n := range networks {
+		m.discardNetwork(req.Interface, ifacePath, n.path, n.refs)
+	}
+	m.requestExpiryCheck()
+
+	if m.state != nil {
+		if err := m.state.Delete(req.Interface); err != nil {
+			log.Printf("[ERROR] Failed to delete desired state of %s: %v", req.Interface, err)
+		}
+	}
+
 	return &pb.DisconnectResponse{Success: true, Message: "Disconnected"}, nil
 }
 
+// Reconcile brings wpa_supplicant in line with the persisted desired state. For
+// each stored interface, networks left over from a previous run are removed and
+// the stored configuration is applied again. It is intended to be called once at
+// startup, before serving requests.
+//
+// Interfaces that fail to reconcile do not stop the others; their errors are
+// joined in the returned error. They are restored once wpa_supplicant appears on
+// the bus, so a service started before wpa_supplicant catches up.
+func (m *InterfaceManager) Reconcile() error {
+	if m.state == nil {
+		return nil
+	}
+
+	desired, loadErr := m.state.Load()
+	errs := []error{loadErr}
+	for _, req := range desired {
+		// Networks from a previous run are unknown to this manager; drop them
+		if ifacePath, err := m.client.GetInterfacePathByName(req.Interface); err == nil {
+			if err := m.client.RemoveAllNetworks(ifacePath); err != nil {
+				log.Printf("[WARN] Failed to clear stale networks on %s: %v", req.Interface, err)
+			}
+		}
+
+		resp, err := m.Configure(req)
+		switch {
+		case err != nil:
+			errs = append(errs, fmt.Errorf("%s: %v", req.Interface, err))
+		case !resp.Success:
+			errs = append(errs, fmt.Errorf("%s: %s", req.Interface, resp.Message))
+		default:
+			log.Printf("[INFO] Restored %s (%s) from desired state", req.Interface, req.EapType)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Status reads the current 802.1X state of a managed interface from wpa_supplicant.
+//
+// Returns ErrInterfaceNotFound if the interface has not been configured through
+// this manager or wpa_supplicant no longer knows it.
+func (m *InterfaceManager) Status(ifname string) (*pb.InterfaceStatus, error) {
+	ifacePath, err := m.lookup(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := m.readState(ifacePath)
+	if err != nil {
+		return nil, err
+	}
+	return m.statusFor(ifname, st), nil
+}
+
+// WatchStatus streams the status of a managed interface. An initial snapshot is
+// delivered first, followed by a new status each time wpa_supplicant reports a
+// change of the interface state, current network or authentication mode. If
+// wpa_supplicant exits or restarts, a status with LastEvent "supplicant-stopped"
+// or "supplicant-restarted" is delivered and the watch follows the new objects.
+// Certificates of the interface within the expiry window are reported with
+// LastEvent "certificate-expiring" and the certificate in EventDetail, after the
+// snapshot and whenever another one enters the window.
+//
+// The returned channel is closed when ctx is done, the signal subscription ends or
+// the interface is no longer managed after a restart.
+// Returns ErrInterfaceNotFound if the interface is not managed.
+func (m *InterfaceManager) WatchStatus(ctx context.Context, ifname string) (<-chan *pb.InterfaceStatus, error) {
+	ifacePath, err := m.lookup(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle, unsubscribe := m.events.subscribe()
+	warnings, unsubscribeWarnings := m.expiry.subscribe()
+	changes, cancel, err := m.client.SubscribePropertiesChanged(ifacePath)
+	if err != nil {
+		unsubscribeWarnings()
+		unsubscribe()
+		return nil, err
+	}
+
+	// Take the snapshot after subscribing so no change can slip in between
+	st, err := m.readState(ifacePath)
+	if err != nil {
+		cancel()
+		unsubscribeWarnings()
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan *pb.InterfaceStatus, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		defer unsubscribeWarnings()
+		defer func() { cancel() }()
+
+		snapshot := m.statusFor(ifname, st)
+		snapshot.LastEvent = "snapshot"
+		if !sendStatus(ctx, out, snapshot) {
+			return
+		}
+		warn := func(detail string) bool {
+			update := m.statusFor(ifname, st)
+			update.LastEvent = "certificate-expiring"
+			update.EventDetail = detail
+			return sendStatus(ctx, out, update)
+		}
+		for _, detail := range m.expiryWarnings(ifname) {
+			if !warn(detail) {
+				return
+			}
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case w, ok := <-warnings:
+				if !ok {
+					return
+				}
+				if w.ifname == ifname && !warn(w.detail) {
+					return
+				}
+			case ev, ok := <-lifecycle:
+				if !ok {
+					return
+				}
+				// The old object path is gone either way; follow the new one after a restart
+				cancel()
+				changes = nil
+				if ev == supplicantStopped {
+					st = &dbus.InterfaceState{Ifname: ifname, CurrentNetwork: "/"}
+				} else {
+					if ifacePath, err = m.lookup(ifname); err != nil {
+						return
+					}
+					if changes, cancel, err = m.client.SubscribePropertiesChanged(ifacePath); err != nil {
+						return
+					}
+					if st, err = m.readState(ifacePath); err != nil {
+						return
+					}
+				}
+				update := m.statusFor(ifname, st)
+				update.LastEvent = ev.String()
+				if !sendStatus(ctx, out, update) {
+					return
+				}
+			case changed, ok := <-changes:
+				if !ok {
+					return
+				}
+				if !st.ApplyChanges(changed) {
+					continue
+				}
+				update := m.statusFor(ifname, st)
+				update.LastEvent = "state-changed"
+				if !sendStatus(ctx, out, update) {
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// WatchEAP streams the EAP signals wpa_supplicant emits for a managed interface,
+// such as method negotiation, server certificate verification and completion.
+// The watch survives wpa_supplicant restarts.
+//
+// The returned channel is closed when ctx is done or the signal subscription ends.
+// Returns ErrInterfaceNotFound if the interface is not managed.
+func (m *InterfaceManager) WatchEAP(ctx context.Context, ifname string) (<-chan *pb.EapEvent, error) {
+	ifacePath, err := m.lookup(ifname)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycle, unsubscribe := m.events.subscribe()
+	events, cancel, err := m.client.SubscribeEAP(ifacePath)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan *pb.EapEvent, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		defer func() { cancel() }()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ev, ok := <-lifecycle:
+				if !ok {
+					return
+				}
+				cancel()
+				events = nil
+				if ev == supplicantRestarted {
+					if ifacePath, err = m.lookup(ifname); err != nil {
+						return
+					}
+					if events, cancel, err = m.client.SubscribeEAP(ifacePath); err != nil {
+						return
+					}
+				}
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				select {
+				case out <- buildEapEvent(ifname, ev):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+// managedInterfaces returns the names of all managed interfaces.
+func (m *InterfaceManager) managedInterfaces() []string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	names := make([]string, 0, len(m.interfaces))
+	for name := range m.interfaces {
+		names = append(names, name)
+	}
+	return names
+}
+
+// selectedNetwork returns the selected credential set of a managed interface and
+// its network, if any.
+func (m *InterfaceManager) selectedNetwork(ifname string) (int, godbus.ObjectPath) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if entry, ok := m.interfaces[ifname]; ok {
+		return entry.active, entry.network()
+	}
+	return 0, ""
+}
+
+// lookup returns the wpa_supplicant object path of a managed interface.
+func (m *InterfaceManager) lookup(ifname string) (godbus.ObjectPath, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	entry, ok := m.interfaces[ifname]
+	if !ok {
+		return "", fmt.Errorf("%w: %s is not managed", ErrInterfaceNotFound, ifname)
+	}
+	if entry.path == "" {
+		return "", fmt.Errorf("%w: %s is not present in wpa_supplicant", ErrSupplicantUnavailable, ifname)
+	}
+	return entry.path, nil
+}
+
+// discardNetwork removes a network from wpa_supplicant together with the credential
+// blobs and files it referenced. A network that no longer exists is not an error.
+func (m *InterfaceManager) discardNetwork(ifname string, ifacePath, netPath godbus.ObjectPath, refs credentialRefs) {
+	err := m.client.RemoveNetwork(ifacePath, netPath)
+	if err != nil && !errors.Is(err, dbus.ErrNetworkUnknown) {
+		log.Printf("[WARN] Failed to remove network %s from %s: %v", netPath, ifname, err)
+	}
+	m.releaseCredentials(ifname, ifacePath, refs)
+}
+
+// lockInterface acquires the per-interface lock that serializes configuration
+// changes on an interface and returns the function that releases it.
+func (m *InterfaceManager) lockInterface(ifname string) func() {
+	m.mu.Lock()
+	l, ok := m.ifaceLocks[ifname]
+	if !ok {
+		l = &ifaceLock{}
+		m.ifaceLocks[ifname] = l
+	}
+	l.refs++
+	m.mu.Unlock()
+
+	l.Lock()
+	return func() {
+		l.Unlock()
+		m.mu.Lock()
+		if l.refs--; l.refs == 0 {
+			delete(m.ifaceLocks, ifname)
+		}
+		m.mu.Unlock()
+	}
+}
+
+// readState reads the interface state from wpa_supplicant, translating an
+// unknown object path into ErrInterfaceNotFound.
+func (m *InterfaceManager) readState(ifacePath godbus.ObjectPath) (*dbus.InterfaceState, error) {
+	st, err := m.client.GetInterfaceState(ifacePath)
+	if err != nil {
+		if errors.Is(err, dbus.ErrInterfaceUnknown) {
+			return nil, fmt.Errorf("%w: %v", ErrInterfaceNotFound, err)
+		}
+		return nil, err
+	}
+	return st, nil
+}
+
+// statusFor builds the status of an interface from its wpa_supplicant state, the
+// kernel link state and its applied configuration. Link information is omitted if
+// it cannot be read.
+func (m *InterfaceManager) statusFor(ifname string, st *dbus.InterfaceState) *pb.InterfaceStatus {
+	status := buildStatus(ifname, st)
+	info, err := m.links.LinkInfo(ifname)
+	if err == nil {
+		applyLinkInfo(status, info)
+	}
+
+	m.mu.Lock()
+	var cfg *pb.Dot1XConfigRequest
+	var chain *pb.CredentialChainStatus
+	if entry, ok := m.interfaces[ifname]; ok && entry.config != nil {
+		cfg = entry.sets[entry.active]
+		chain = chainStatus(entry, st)
+	}
+	m.mu.Unlock()
+	if cfg != nil {
+		applyConfig(status, cfg)
+		status.CredentialChain = chain
+		if mode := macsecMode(cfg); mode != pb.MacsecMode_MACSEC_MODE_DISABLED {
+			status.Macsec = m.macsecStatus(mode, st, info)
+		}
+		if cfg.Wireless != nil {
+			status.Wireless = m.wirelessStatus(cfg.Wireless, st)
+		}
+	}
+	return status
+}
+
+// sendStatus delivers a status update unless ctx is done first.
+// Returns false if the context was canceled.
+func sendStatus(ctx context.Context, out chan<- *pb.InterfaceStatus, st *pb.InterfaceStatus) bool {
+	select {
+	case out <- st:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 // Shutdown performs cleanup operations when the service is shutting down.
 // It removes all temporary certificate files and disconnects all managed interfaces.
 func (m *InterfaceManager) Shutdown() {
-	// Clean up temporary certificate files
-	for _, path := range m.tempFiles {
-		os.Remove(path)
+	m.stopLifecycle()
+	m.stopExpiry()
+
+	// End the failover watches before their interfaces go away
+	m.mu.Lock()
+	var stops []func()
+	for _, entry := range m.interfaces {
+		_, stop := entry.release()
+		stops = append(stops, stop)
+	}
+	m.mu.Unlock()
+	for _, stop := range stops {
+		stop()
 	}
 
-	// Remove all managed interfaces
-	for _, iface := range m.interfaces {
-		m.client.RemoveInterface(iface)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, entry := range m.interfaces {
+		// Remove the managed interface, unless it went away with wpa_supplicant
+		if entry.path != "" {
+			m.client.RemoveInterface(entry.path)
+		}
+	}
+
+	// Clean up the credential files of every interface
+	if m.creds != nil {
+		if err := m.creds.Close(); err != nil {
+			log.Printf("[WARN] Failed to remove credentials: %v", err)
+		}
+	}
+	if m.pacs != nil {
+		if err := m.pacs.Close(); err != nil {
+			log.Printf("[WARN] Failed to remove PAC directory: %v", err)
+		}
 	}
 
 	// Close the D-Bus connection
 	m.client.Close()
 }
-
-// writeTempFile writes the provided content to a temporary file with the given filename.
-// The file is created in /tmp with a unique timestamp prefix and restrictive permissions.
-//
-// Returns the full path to the created file or an error if the operation fails.
-func writeTempFile(content []byte, filename string) (string, error) {
-	tmpPath := fmt.Sprintf("/tmp/%d_%s", time.Now().UnixNano(), filename)
-	if err := os.WriteFile(tmpPath, content, 0600); err != nil {
-		return "", errors.New("failed to write temp file: " + err.Error())
-	}
-	return tmpPath, nil
-}
@@ -0,0 +1,108 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file builds and validates the network fields specific to each EAP method,
+// and reports which methods the local wpa_supplicant supports.
+package core
+
+import (
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// eapMethodName returns the wpa_supplicant name of an EAP method (e.g. "PEAP").
+func eapMethodName(t pb.EapType) string {
+	return strings.TrimPrefix(t.String(), "EAP_")
+}
+
+// tunneled reports whether an EAP method protects the real identity inside a TLS
+// tunnel, so that an anonymous outer identity can be sent in its place.
+func tunneled(t pb.EapType) bool {
+	switch t {
+	case pb.EapType_EAP_PEAP, pb.EapType_EAP_TTLS, pb.EapType_EAP_FAST, pb.EapType_EAP_TEAP:
+		return true
+	}
+	return false
+}
+
+// SupportedEapMethods returns the EAP methods of the API that the local
+// wpa_supplicant supports, together with every method it reports. Returns
+// ErrSupplicantUnavailable if wpa_supplicant cannot be asked.
+func (m *InterfaceManager) SupportedEapMethods() ([]pb.EapType, []string, error) {
+	methods, err := m.client.EapMethods()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %v", ErrSupplicantUnavailable, err)
+	}
+
+	var supported []pb.EapType
+	for t := pb.EapType_EAP_UNKNOWN + 1; int(t) < len(pb.EapType_name); t++ {
+		if slices.Contains(methods, eapMethodName(t)) {
+			supported = append(supported, t)
+		}
+	}
+	return supported, methods, nil
+}
+
+// validateEAPMethod checks that wpa_supplicant supports the EAP method of a request
+// and that the fields the method needs are present. EAP-TLS and EAP-FAST have
+// their own credential checks, and server validation is checked separately.
+func (m *InterfaceManager) validateEAPMethod(req *pb.Dot1XConfigRequest) fieldErrors {
+	var errs fieldErrors
+	name := eapMethodName(req.EapType)
+
+	// Without the list the method is left for wpa_supplicant to reject
+	if methods, err := m.client.EapMethods(); err != nil {
+		log.Printf("[WARN] Cannot read the EAP methods supported by wpa_supplicant: %v", err)
+	} else if !slices.Contains(methods, name) {
+		errs.add("eap_type", fmt.Sprintf("EAP-%s is not supported by wpa_supplicant", name))
+		return errs
+	}
+
+	if req.AnonymousIdentity != "" && !tunneled(req.EapType) {
+		errs.add("anonymous_identity", "is not used by EAP-"+name)
+	}
+
+	switch req.EapType {
+	case pb.EapType_EAP_TEAP:
+		if req.Password == "" {
+			errs.add("password", "is required")
+		}
+
+	case pb.EapType_EAP_PWD, pb.EapType_EAP_MD5, pb.EapType_EAP_GTC:
+		// Password-only methods: certificates are never used
+		if req.Password == "" {
+			errs.add("password", "is required")
+		}
+		unused := []struct {
+			field string
+			data  []byte
+		}{
+			{"client_cert", req.ClientCert},
+			{"private_key", req.PrivateKey},
+			{"pkcs12", req.Pkcs12},
+		}
+		for _, u := range unused {
+			if len(u.data) > 0 {
+				errs.add(u.field, "is not used by EAP-"+name)
+			}
+		}
+	}
+	return errs
+}
+
+// methodConfig adds the anonymous outer identity and inner method of the tunneled
+// methods, and the password of the password-based methods, to cfg.
+func methodConfig(req *pb.Dot1XConfigRequest, phase2 pb.Phase2Method, cfg map[string]string) {
+	if req.AnonymousIdentity != "" && tunneled(req.EapType) {
+		cfg["anonymous_identity"] = req.AnonymousIdentity
+	}
+	if phase2 != pb.Phase2Method_PHASE2_UNSPECIFIED {
+		cfg["phase2"] = phase2Setting(phase2)
+	}
+
+	if req.EapType != pb.EapType_EAP_TLS {
+		cfg["password"] = req.Password
+	}
+}
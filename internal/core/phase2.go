@@ -0,0 +1,114 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file resolves the inner (phase 2) authentication method of the tunneled EAP
+// methods and checks that the outer method supports it.
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// phase2Rules are the inner methods each tunneled EAP method accepts; the first is
+// used when the request does not name one. Methods without an entry take no inner
+// method.
+var phase2Rules = map[pb.EapType][]pb.Phase2Method{
+	pb.EapType_EAP_PEAP: {pb.Phase2Method_PHASE2_MSCHAPV2, pb.Phase2Method_PHASE2_GTC, pb.Phase2Method_PHASE2_MD5},
+	pb.EapType_EAP_TTLS: {
+		pb.Phase2Method_PHASE2_MSCHAPV2, pb.Phase2Method_PHASE2_PAP, pb.Phase2Method_PHASE2_CHAP, pb.Phase2Method_PHASE2_MSCHAP,
+		pb.Phase2Method_PHASE2_EAP_MSCHAPV2, pb.Phase2Method_PHASE2_EAP_GTC, pb.Phase2Method_PHASE2_EAP_MD5,
+	},
+	pb.EapType_EAP_FAST: {pb.Phase2Method_PHASE2_MSCHAPV2, pb.Phase2Method_PHASE2_GTC},
+	pb.EapType_EAP_TEAP: {pb.Phase2Method_PHASE2_MSCHAPV2, pb.Phase2Method_PHASE2_GTC},
+}
+
+// phase2Name returns the wpa_supplicant name of an inner method (e.g. "MSCHAPV2"),
+// and whether it is carried in an inner EAP exchange.
+func phase2Name(method pb.Phase2Method) (string, bool) {
+	name := strings.TrimPrefix(method.String(), "PHASE2_")
+	if eap, ok := strings.CutPrefix(name, "EAP_"); ok {
+		return eap, true
+	}
+	return name, false
+}
+
+// phase2Setting returns the wpa_supplicant phase2 value of an inner method. TTLS
+// distinguishes EAP inner methods ("autheap=") from its legacy ones ("auth=").
+func phase2Setting(method pb.Phase2Method) string {
+	name, eap := phase2Name(method)
+	if eap {
+		return "autheap=" + name
+	}
+	return "auth=" + name
+}
+
+// parsePhase2Auth converts the legacy phase2_auth string ("mschapv2",
+// "auth=MSCHAPV2" or "autheap=GTC") to an inner method.
+func parsePhase2Auth(s string) (pb.Phase2Method, bool) {
+	name := strings.ToUpper(s)
+	if rest, ok := strings.CutPrefix(name, "AUTHEAP="); ok {
+		name = "EAP_" + rest
+	} else {
+		name = strings.TrimPrefix(name, "AUTH=")
+	}
+	v, ok := pb.Phase2Method_value["PHASE2_"+name]
+	if !ok || v == int32(pb.Phase2Method_PHASE2_UNSPECIFIED) {
+		return pb.Phase2Method_PHASE2_UNSPECIFIED, false
+	}
+	return pb.Phase2Method(v), true
+}
+
+// resolvePhase2 returns the inner method to configure for a request: the phase2
+// field, else the legacy phase2_auth string, else the default of the outer method.
+// Methods without an inner method return PHASE2_UNSPECIFIED.
+//
+// Returns a ValidationError if the inner method is unknown, conflicts between the
+// two fields, or is not supported by the outer method.
+func resolvePhase2(req *pb.Dot1XConfigRequest) (pb.Phase2Method, error) {
+	var errs fieldErrors
+	method, field := req.Phase2, "phase2"
+	if req.Phase2Auth != "" {
+		legacy, ok := parsePhase2Auth(req.Phase2Auth)
+		switch {
+		case !ok:
+			errs.add("phase2_auth", fmt.Sprintf("unknown inner method %q", req.Phase2Auth))
+		case method == pb.Phase2Method_PHASE2_UNSPECIFIED:
+			method, field = legacy, "phase2_auth"
+		case method != legacy:
+			errs.add("phase2_auth", fmt.Sprintf("%q conflicts with phase2 %s", req.Phase2Auth, method))
+		}
+	}
+	if len(errs) > 0 {
+		return pb.Phase2Method_PHASE2_UNSPECIFIED, errs.invalid()
+	}
+
+	outer := "EAP-" + eapMethodName(req.EapType)
+	allowed, ok := phase2Rules[req.EapType]
+	switch {
+	case !ok:
+		if method != pb.Phase2Method_PHASE2_UNSPECIFIED {
+			errs.add(field, outer+" has no inner method")
+		}
+	case method == pb.Phase2Method_PHASE2_UNSPECIFIED:
+		method = allowed[0]
+	case !slices.Contains(allowed, method):
+		names := make([]string, len(allowed))
+		for i, m := range allowed {
+			names[i] = m.String()
+		}
+		errs.add(field, fmt.Sprintf("%s is not supported by %s (use %s)", method, outer, strings.Join(names, ", ")))
+	}
+
+	// Anonymous provisioning runs EAP-MSCHAPv2 inside an unauthenticated tunnel
+	if req.EapType == pb.EapType_EAP_FAST && method != pb.Phase2Method_PHASE2_MSCHAPV2 &&
+		req.FastProvisioning == pb.FastProvisioning_FAST_PROVISIONING_UNAUTHENTICATED {
+		errs.add(field, fmt.Sprintf("unauthenticated PAC provisioning requires %s", pb.Phase2Method_PHASE2_MSCHAPV2))
+	}
+
+	if len(errs) > 0 {
+		return pb.Phase2Method_PHASE2_UNSPECIFIED, errs.invalid()
+	}
+	return method, nil
+}
@@ -0,0 +1,223 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file implements configuration with progress reporting, which follows an
+// authentication from network selection through to its outcome.
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	godbus "github.com/godbus/dbus/v5"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// Defaults for ConfigureAndWatch
+const (
+	defaultWatchTimeout = 30 * time.Second
+	ipPollInterval      = 500 * time.Millisecond
+)
+
+// ProgressFunc receives the progress of a ConfigureAndWatch call. Returning an
+// error aborts the watch.
+type ProgressFunc func(*pb.ConfigureProgress) error
+
+// progressTracker emits stages in order, dropping any stage that does not move
+// the authentication forward (signals may arrive out of order across channels).
+type progressTracker struct {
+	ifname string
+	emit   ProgressFunc
+	stage  pb.ConfigureStage
+}
+
+// advance reports a stage if it is later than the last one reported.
+func (p *progressTracker) advance(stage pb.ConfigureStage, msg string) error {
+	if stage <= p.stage {
+		return nil
+	}
+	p.stage = stage
+	return p.send(stage, msg, false, false)
+}
+
+// rewind reports a stage again after the authentication started over, such as
+// when failover selects another network, so later stages are reported again.
+func (p *progressTracker) rewind(stage pb.ConfigureStage, msg string) error {
+	p.stage = stage
+	return p.send(stage, msg, false, false)
+}
+
+// finish reports a terminal stage with its outcome.
+func (p *progressTracker) finish(stage pb.ConfigureStage, msg string, success bool) error {
+	p.stage = stage
+	return p.send(stage, msg, true, success)
+}
+
+func (p *progressTracker) send(stage pb.ConfigureStage, msg string, terminal, success bool) error {
+	return p.emit(&pb.ConfigureProgress{
+		Interface: p.ifname,
+		Stage:     stage,
+		Message:   msg,
+		Terminal:  terminal,
+		Success:   success,
+		Timestamp: time.Now().Unix(),
+	})
+}
+
+// ConfigureAndWatch applies the configuration like Configure and then follows the
+// authentication, reporting each stage through emit until a terminal stage is
+// reached: authentication success (or address acquisition when req.WaitForIp is
+// set), EAP failure, or the timeout expiring. With fallback credential sets, EAP
+// failure is terminal only once the last one has been rejected.
+//
+// Returns an error only if ctx is canceled, emit fails or the request is an invalid
+// argument (see Configure); other configuration and authentication failures are
+// reported as a terminal FAILED stage.
+func (m *InterfaceManager) ConfigureAndWatch(ctx context.Context, req *pb.ConfigureAndWatchRequest, emit ProgressFunc) error {
+	cfg := req.Config
+	if cfg == nil {
+		cfg = &pb.Dot1XConfigRequest{}
+	}
+	timeout := defaultWatchTimeout
+	if req.TimeoutSeconds > 0 {
+		timeout = time.Duration(req.TimeoutSeconds) * time.Second
+	}
+
+	progress := &progressTracker{ifname: cfg.Interface, emit: emit}
+	var (
+		eapEvents <-chan dbus.EAPEvent
+		changes   <-chan map[string]godbus.Variant
+		state     *dbus.InterfaceState
+		cancels   []func()
+	)
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
+		}
+	}()
+
+	resp, err := m.configure(cfg, func(ifacePath godbus.ObjectPath) error {
+		if err := progress.advance(pb.ConfigureStage_CONFIGURE_STAGE_NETWORK_ADDED, "Network added"); err != nil {
+			return err
+		}
+
+		var cancel func()
+		var err error
+		if eapEvents, cancel, err = m.client.SubscribeEAP(ifacePath); err != nil {
+			return err
+		}
+		cancels = append(cancels, cancel)
+		if changes, cancel, err = m.client.SubscribePropertiesChanged(ifacePath); err != nil {
+			return err
+		}
+		cancels = append(cancels, cancel)
+		state, err = m.readState(ifacePath)
+		return err
+	})
+	if errors.Is(err, ErrInvalidArgument) {
+		return err
+	}
+	if err != nil {
+		return progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_FAILED, err.Error(), false)
+	}
+	if !resp.Success {
+		return progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_FAILED, resp.Message, false)
+	}
+	if err := progress.advance(pb.ConfigureStage_CONFIGURE_STAGE_NETWORK_SELECTED, "Network selected"); err != nil {
+		return err
+	}
+
+	// On reconfigure the previous network may still be completed while wpa_supplicant
+	// switches over; only the selected network reaching "completed" is a success
+	active, selected := m.selectedNetwork(cfg.Interface)
+
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	poll := time.NewTicker(ipPollInterval)
+	defer poll.Stop()
+
+	// authenticated reports success, or moves on to waiting for an address.
+	authenticated := func(msg string) (bool, error) {
+		if !req.WaitForIp {
+			return true, progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_AUTHENTICATED, msg, true)
+		}
+		if err := progress.advance(pb.ConfigureStage_CONFIGURE_STAGE_AUTHENTICATED, msg); err != nil {
+			return false, err
+		}
+		return m.checkAddress(cfg.Interface, progress)
+	}
+
+	for {
+		var done bool
+		var err error
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+
+		case <-deadline.C:
+			msg := fmt.Sprintf("No outcome within %s", timeout)
+			return progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_TIMED_OUT, msg, false)
+
+		case ev, ok := <-eapEvents:
+			if !ok {
+				return progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_FAILED, "EAP signal subscription ended", false)
+			}
+			switch eapEventTypes[ev.Status] {
+			case pb.EapEventType_EAP_EVENT_STARTED:
+				err = progress.advance(pb.ConfigureStage_CONFIGURE_STAGE_EAPOL_STARTED, "EAP started")
+			case pb.EapEventType_EAP_EVENT_METHOD_SELECTED:
+				err = progress.advance(pb.ConfigureStage_CONFIGURE_STAGE_METHOD_NEGOTIATED, "EAP method "+ev.Parameter)
+			case pb.EapEventType_EAP_EVENT_COMPLETION:
+				switch {
+				case ev.Parameter == "success":
+					done, err = authenticated("EAP authentication succeeded")
+				default:
+					// Failover selects the next credential set, if any; follow it from the start
+					next := m.fallbackNetwork(cfg.Interface, active)
+					if next == "" {
+						return progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_FAILED, "EAP authentication failed", false)
+					}
+					active, selected = active+1, next
+					err = progress.rewind(pb.ConfigureStage_CONFIGURE_STAGE_NETWORK_SELECTED,
+						fmt.Sprintf("EAP authentication failed; falling back to credential set %d", active))
+				}
+			}
+
+		case changed, ok := <-changes:
+			if !ok {
+				return progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_FAILED, "Property signal subscription ended", false)
+			}
+			prev := state.State
+			state.ApplyChanges(changed)
+			if prev != "completed" && state.State == "completed" && state.CurrentNetwork == selected {
+				done, err = authenticated("Supplicant state completed")
+			}
+
+		case <-poll.C:
+			if progress.stage == pb.ConfigureStage_CONFIGURE_STAGE_AUTHENTICATED {
+				done, err = m.checkAddress(cfg.Interface, progress)
+			}
+		}
+
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// checkAddress finishes the watch with IP_ACQUIRED once the interface has a
+// routable address. Returns true if the watch is finished.
+func (m *InterfaceManager) checkAddress(ifname string, progress *progressTracker) (bool, error) {
+	info, err := m.links.LinkInfo(ifname)
+	if err != nil {
+		return false, nil
+	}
+	addr := info.PrimaryAddress()
+	if addr == "" {
+		return false, nil
+	}
+	return true, progress.finish(pb.ConfigureStage_CONFIGURE_STAGE_IP_ACQUIRED, "Address "+addr, true)
+}
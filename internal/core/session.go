@@ -0,0 +1,80 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file controls the EAPOL session of a configured interface: reauthentication
+// and EAPOL-Logoff/Logon, which leave its configuration in place.
+package core
+
+import (
+	"errors"
+	"log"
+
+	godbus "github.com/godbus/dbus/v5"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// Reauthenticate starts a new EAP authentication on an interface with its current
+// configuration, e.g. after the switch changed the VLAN policy of the port. The
+// outcome is reported by the status and EAP event streams.
+func (m *InterfaceManager) Reauthenticate(req *pb.InterfaceRequest) (*pb.ReauthenticateResponse, error) {
+	ok, msg := m.controlSession(req.Interface, "Reauthentication started", m.client.Reauthenticate)
+	return &pb.ReauthenticateResponse{Success: ok, Message: msg}, nil
+}
+
+// EapolLogoff sends EAPOL-Logoff on an interface. The interface keeps its
+// configuration but does not authenticate again until EapolLogon, including when
+// its configuration is applied again or re-applied after a wpa_supplicant restart.
+func (m *InterfaceManager) EapolLogoff(req *pb.InterfaceRequest) (*pb.EapolLogoffResponse, error) {
+	ok, msg := m.controlSession(req.Interface, "Logged off", m.logoffCall(req.Interface, m.client.EAPLogoff, true))
+	return &pb.EapolLogoffResponse{Success: ok, Message: msg}, nil
+}
+
+// EapolLogon ends an EAPOL-Logoff, so the interface authenticates again.
+func (m *InterfaceManager) EapolLogon(req *pb.InterfaceRequest) (*pb.EapolLogonResponse, error) {
+	ok, msg := m.controlSession(req.Interface, "Logged on", m.logoffCall(req.Interface, m.client.EAPLogon, false))
+	return &pb.EapolLogonResponse{Success: ok, Message: msg}, nil
+}
+
+// logoffCall wraps EAPLogoff or EAPLogon so that, once the call succeeds, the
+// interface is recorded as logged off or not.
+func (m *InterfaceManager) logoffCall(ifname string, call func(godbus.ObjectPath) error, loggedOff bool) func(godbus.ObjectPath) error {
+	return func(ifacePath godbus.ObjectPath) error {
+		if err := call(ifacePath); err != nil {
+			return err
+		}
+		m.mu.Lock()
+		if entry, ok := m.interfaces[ifname]; ok {
+			entry.loggedOff = loggedOff
+		}
+		m.mu.Unlock()
+		return nil
+	}
+}
+
+// controlSession calls a wpa_supplicant session method on an interface the manager
+// has configured a network on. It returns whether the call succeeded and the
+// message to report.
+func (m *InterfaceManager) controlSession(ifname, done string, call func(godbus.ObjectPath) error) (bool, string) {
+	unlock := m.lockInterface(ifname)
+	defer unlock()
+
+	ifacePath, err := m.lookup(ifname)
+	if err != nil {
+		return false, "Interface not managed"
+	}
+	m.mu.Lock()
+	configured := m.interfaces[ifname].network() != ""
+	m.mu.Unlock()
+	if !configured {
+		return false, "Interface has no network configured"
+	}
+
+	if err := call(ifacePath); err != nil {
+		if errors.Is(err, dbus.ErrNotConnected) {
+			return false, "Interface not connected"
+		}
+		log.Printf("[ERROR] Failed to control the EAPOL session of %s: %v", ifname, err)
+		return false, err.Error()
+	}
+	return true, done
+}
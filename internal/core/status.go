@@ -0,0 +1,123 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file translates raw wpa_supplicant interface state into the typed status
+// values exposed through the gRPC API.
+package core
+
+import (
+	"time"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	"github.com/gavmckee80/dot1x-grpc/internal/netlink"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// supplicantStates maps wpa_supplicant State property values to their protobuf enum.
+var supplicantStates = map[string]pb.SupplicantState{
+	"disconnected":       pb.SupplicantState_SUPPLICANT_STATE_DISCONNECTED,
+	"interface_disabled": pb.SupplicantState_SUPPLICANT_STATE_INTERFACE_DISABLED,
+	"inactive":           pb.SupplicantState_SUPPLICANT_STATE_INACTIVE,
+	"scanning":           pb.SupplicantState_SUPPLICANT_STATE_SCANNING,
+	"authenticating":     pb.SupplicantState_SUPPLICANT_STATE_AUTHENTICATING,
+	"associating":        pb.SupplicantState_SUPPLICANT_STATE_ASSOCIATING,
+	"associated":         pb.SupplicantState_SUPPLICANT_STATE_ASSOCIATED,
+	"4way_handshake":     pb.SupplicantState_SUPPLICANT_STATE_4WAY_HANDSHAKE,
+	"group_handshake":    pb.SupplicantState_SUPPLICANT_STATE_GROUP_HANDSHAKE,
+	"completed":          pb.SupplicantState_SUPPLICANT_STATE_COMPLETED,
+}
+
+// parseSupplicantState converts a wpa_supplicant State property value to its enum.
+// Unrecognized values map to SUPPLICANT_STATE_UNKNOWN.
+func parseSupplicantState(state string) pb.SupplicantState {
+	if s, ok := supplicantStates[state]; ok {
+		return s
+	}
+	return pb.SupplicantState_SUPPLICANT_STATE_UNKNOWN
+}
+
+// eapStateFor derives the EAP authentication state from the supplicant state.
+// On wired 802.1X the supplicant only reaches "completed" once EAP has succeeded
+// and the port is authorized, so the intermediate states mean EAP is in progress.
+func eapStateFor(state pb.SupplicantState) pb.EapState {
+	switch state {
+	case pb.SupplicantState_SUPPLICANT_STATE_COMPLETED:
+		return pb.EapState_EAP_STATE_SUCCESS
+	case pb.SupplicantState_SUPPLICANT_STATE_AUTHENTICATING,
+		pb.SupplicantState_SUPPLICANT_STATE_ASSOCIATING,
+		pb.SupplicantState_SUPPLICANT_STATE_ASSOCIATED,
+		pb.SupplicantState_SUPPLICANT_STATE_4WAY_HANDSHAKE,
+		pb.SupplicantState_SUPPLICANT_STATE_GROUP_HANDSHAKE:
+		return pb.EapState_EAP_STATE_IN_PROGRESS
+	case pb.SupplicantState_SUPPLICANT_STATE_DISCONNECTED,
+		pb.SupplicantState_SUPPLICANT_STATE_INTERFACE_DISABLED,
+		pb.SupplicantState_SUPPLICANT_STATE_INACTIVE,
+		pb.SupplicantState_SUPPLICANT_STATE_SCANNING:
+		return pb.EapState_EAP_STATE_IDLE
+	}
+	return pb.EapState_EAP_STATE_UNKNOWN
+}
+
+// buildStatus converts a wpa_supplicant interface state into an InterfaceStatus.
+// The legacy string fields are kept populated for clients that predate the enums.
+// The interface options are those wpa_supplicant reports, not the requested ones.
+func buildStatus(ifname string, st *dbus.InterfaceState) *pb.InterfaceStatus {
+	state := parseSupplicantState(st.State)
+	eap := eapStateFor(state)
+
+	network := ""
+	if st.CurrentNetwork != "/" {
+		network = string(st.CurrentNetwork)
+	}
+
+	return &pb.InterfaceStatus{
+		Interface:       ifname,
+		Status:          st.State,
+		EapState:        eapStateName(eap),
+		SupplicantState: state,
+		EapStatus:       eap,
+		CurrentNetwork:  network,
+		AuthMode:        st.CurrentAuthMode,
+		Timestamp:       time.Now().Unix(),
+		InterfaceOptions: &pb.InterfaceOptions{
+			Driver:          st.Options.Driver,
+			BridgeInterface: st.Options.BridgeIfname,
+			ConfigFile:      st.Options.ConfigFile,
+			EapolVersion:    st.Options.EapolVersion,
+			FastReauth:      st.Options.FastReauth,
+			ApScan:          st.Options.ApScan,
+		},
+	}
+}
+
+// applyLinkInfo adds the kernel link state to a status. The legacy ip_address
+// field carries the primary address for clients that predate the repeated fields.
+func applyLinkInfo(status *pb.InterfaceStatus, info *netlink.LinkInfo) {
+	status.Ipv4Addresses = info.IPv4
+	status.Ipv6Addresses = info.IPv6
+	status.IpAddress = info.PrimaryAddress()
+	status.Carrier = info.Carrier
+	status.MacAddress = info.MAC
+	status.OperState = info.OperState
+}
+
+// applyConfig adds the method and identities of the applied configuration to a
+// status, so the identity sent in cleartext EAPOL frames can be audited.
+func applyConfig(status *pb.InterfaceStatus, cfg *pb.Dot1XConfigRequest) {
+	status.EapType = cfg.EapType
+	status.Identity = cfg.Identity
+	status.AnonymousIdentity = cfg.AnonymousIdentity
+}
+
+// eapStateName returns the short lower-case name of an EAP state (e.g. "success").
+func eapStateName(s pb.EapState) string {
+	switch s {
+	case pb.EapState_EAP_STATE_IDLE:
+		return "idle"
+	case pb.EapState_EAP_STATE_IN_PROGRESS:
+		return "in-progress"
+	case pb.EapState_EAP_STATE_SUCCESS:
+		return "success"
+	case pb.EapState_EAP_STATE_FAILURE:
+		return "failure"
+	}
+	return "unknown"
+}
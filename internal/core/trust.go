@@ -0,0 +1,187 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file configures how wpa_supplicant verifies the authentication server of the
+// TLS-based EAP methods: trusted CAs, server name matching and TLS options.
+package core
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// altsubjectPrefixes are the subjectAltName types wpa_supplicant can match.
+var altsubjectPrefixes = []string{"EMAIL:", "DNS:", "URI:"}
+
+// tlsVersionFlags are the phase1 flags disabling each TLS version.
+var tlsVersionFlags = map[pb.TlsVersion]string{
+	pb.TlsVersion_TLS_VERSION_1_0: "tls_disable_tlsv1_0",
+	pb.TlsVersion_TLS_VERSION_1_1: "tls_disable_tlsv1_1",
+	pb.TlsVersion_TLS_VERSION_1_2: "tls_disable_tlsv1_2",
+	pb.TlsVersion_TLS_VERSION_1_3: "tls_disable_tlsv1_3",
+}
+
+// tlsBased reports whether an EAP method runs a TLS handshake with the server,
+// and so can verify its certificate.
+func tlsBased(t pb.EapType) bool {
+	switch t {
+	case pb.EapType_EAP_TLS, pb.EapType_EAP_PEAP, pb.EapType_EAP_TTLS, pb.EapType_EAP_FAST, pb.EapType_EAP_TEAP:
+		return true
+	}
+	return false
+}
+
+// verifiesServer reports whether a request gives wpa_supplicant a trust anchor for
+// the server certificate.
+func verifiesServer(req *pb.Dot1XConfigRequest) bool {
+	return len(req.CaCert) > 0 || req.CaPath != ""
+}
+
+// validateServerTrust checks the server validation settings of a request at time
+// now. Methods without a TLS handshake reject them.
+func validateServerTrust(req *pb.Dot1XConfigRequest, now time.Time) fieldErrors {
+	var errs fieldErrors
+	name := eapMethodName(req.EapType)
+
+	if !tlsBased(req.EapType) {
+		unused := []struct {
+			field string
+			set   bool
+		}{
+			{"ca_cert", len(req.CaCert) > 0},
+			{"ca_path", req.CaPath != ""},
+			{"ca_cert2", len(req.CaCert2) > 0},
+			{"domain_suffix_match", req.DomainSuffixMatch != ""},
+			{"domain_match", req.DomainMatch != ""},
+			{"altsubject_match", len(req.AltsubjectMatch) > 0},
+			{"phase1", req.Phase1 != nil},
+		}
+		for _, u := range unused {
+			if u.set {
+				errs.add(u.field, "is not used by EAP-"+name)
+			}
+		}
+		return errs
+	}
+
+	// EAP-TLS checks its own CA certificate along with the client credentials
+	if req.EapType != pb.EapType_EAP_TLS && len(req.CaCert) > 0 {
+		checkCACert(&errs, "ca_cert", req.CaCert, now)
+	}
+	// ca_cert2 verifies the server of an inner TLS method, and no inner method uses TLS
+	if len(req.CaCert2) > 0 {
+		errs.add("ca_cert2", "no inner method of EAP-"+name+" uses TLS")
+	}
+	if req.CaPath != "" {
+		if !filepath.IsAbs(req.CaPath) {
+			errs.add("ca_path", "must be an absolute path")
+		} else if info, err := os.Stat(req.CaPath); err != nil || !info.IsDir() {
+			errs.add("ca_path", fmt.Sprintf("%s is not a directory", req.CaPath))
+		}
+	}
+
+	for _, match := range req.AltsubjectMatch {
+		if strings.Contains(match, ";") || !hasAnyPrefix(match, altsubjectPrefixes) {
+			errs.add("altsubject_match", fmt.Sprintf("%q must be one of %s followed by a value", match, strings.Join(altsubjectPrefixes, ", ")))
+		}
+	}
+	if strings.ContainsAny(req.DomainSuffixMatch, " \t\"") {
+		errs.add("domain_suffix_match", "must not contain whitespace or quotes")
+	}
+	if strings.ContainsAny(req.DomainMatch, " \t\"") {
+		errs.add("domain_match", "must not contain whitespace or quotes")
+	}
+
+	if opts := req.Phase1; opts != nil {
+		if opts.PeapVersion != pb.PeapVersion_PEAP_VERSION_AUTO && req.EapType != pb.EapType_EAP_PEAP {
+			errs.add("phase1", "peap_version only applies to EAP-PEAP")
+		}
+		minVersion, maxVersion := opts.TlsMinVersion, opts.TlsMaxVersion
+		if minVersion != pb.TlsVersion_TLS_VERSION_UNSPECIFIED && maxVersion != pb.TlsVersion_TLS_VERSION_UNSPECIFIED && minVersion > maxVersion {
+			errs.add("phase1", fmt.Sprintf("tls_min_version %s is above tls_max_version %s", minVersion, maxVersion))
+		}
+	}
+	return errs
+}
+
+// serverTrustConfig adds the server name matching and phase1 TLS options of a
+// request to cfg. CA certificates are handed over with the other credentials.
+func serverTrustConfig(req *pb.Dot1XConfigRequest, cfg map[string]string) {
+	if !tlsBased(req.EapType) {
+		return
+	}
+	if !verifiesServer(req) {
+		log.Printf("[WARN] %s: EAP-%s without ca_cert or ca_path does not verify the authentication server", req.Interface, eapMethodName(req.EapType))
+	}
+
+	if req.CaPath != "" {
+		cfg["ca_path"] = req.CaPath
+	}
+	if req.DomainSuffixMatch != "" {
+		cfg["domain_suffix_match"] = req.DomainSuffixMatch
+	}
+	if req.DomainMatch != "" {
+		cfg["domain_match"] = req.DomainMatch
+	}
+	if len(req.AltsubjectMatch) > 0 {
+		cfg["altsubject_match"] = strings.Join(req.AltsubjectMatch, ";")
+	}
+	addPhase1(cfg, phase1Options(req.Phase1)...)
+}
+
+// phase1Options returns the wpa_supplicant phase1 flags for the PEAP version and
+// the range of allowed TLS versions.
+func phase1Options(opts *pb.Phase1Options) []string {
+	if opts == nil {
+		return nil
+	}
+
+	var flags []string
+	switch opts.PeapVersion {
+	case pb.PeapVersion_PEAP_VERSION_0:
+		flags = append(flags, "peapver=0")
+	case pb.PeapVersion_PEAP_VERSION_1:
+		flags = append(flags, "peapver=1")
+	}
+
+	if opts.TlsMinVersion == pb.TlsVersion_TLS_VERSION_UNSPECIFIED && opts.TlsMaxVersion == pb.TlsVersion_TLS_VERSION_UNSPECIFIED {
+		return flags
+	}
+	for v := pb.TlsVersion_TLS_VERSION_1_0; v <= pb.TlsVersion_TLS_VERSION_1_3; v++ {
+		disabled := (opts.TlsMinVersion != pb.TlsVersion_TLS_VERSION_UNSPECIFIED && v < opts.TlsMinVersion) ||
+			(opts.TlsMaxVersion != pb.TlsVersion_TLS_VERSION_UNSPECIFIED && v > opts.TlsMaxVersion)
+		switch {
+		case disabled:
+			flags = append(flags, tlsVersionFlags[v]+"=1")
+		case v == pb.TlsVersion_TLS_VERSION_1_3:
+			// Some wpa_supplicant builds disable TLS 1.3 unless asked for it
+			flags = append(flags, tlsVersionFlags[v]+"=0")
+		}
+	}
+	return flags
+}
+
+// addPhase1 appends flags to the space-separated phase1 field of cfg.
+func addPhase1(cfg map[string]string, flags ...string) {
+	if len(flags) == 0 {
+		return
+	}
+	if cfg["phase1"] != "" {
+		flags = append([]string{cfg["phase1"]}, flags...)
+	}
+	cfg["phase1"] = strings.Join(flags, " ")
+}
+
+// hasAnyPrefix reports whether s starts with one of prefixes.
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,172 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file validates credentials before they are handed to wpa_supplicant and
+// reports each problem against the request field it concerns.
+package core
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/certs"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// ErrInvalidArgument is matched by errors for requests that combine settings
+// wpa_supplicant cannot apply, such as an inner method the outer method lacks.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ValidationError reports the request fields that make a request invalid.
+type ValidationError struct {
+	Fields []*pb.FieldError
+}
+
+// Error lists every problem as "field: reason".
+func (e *ValidationError) Error() string {
+	parts := make([]string, len(e.Fields))
+	for i, fe := range e.Fields {
+		parts[i] = fe.Field + ": " + fe.Reason
+	}
+	return strings.Join(parts, "; ")
+}
+
+// Is makes a ValidationError match ErrInvalidArgument.
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidArgument
+}
+
+// fieldErrors collects validation problems, each tied to a request field.
+type fieldErrors []*pb.FieldError
+
+// add records a problem with a request field, named as in the proto definition.
+func (e *fieldErrors) add(field, reason string) {
+	*e = append(*e, &pb.FieldError{Field: field, Reason: reason})
+}
+
+// invalid returns the problems as a ValidationError.
+func (e fieldErrors) invalid() error {
+	return &ValidationError{Fields: e}
+}
+
+// response builds the failure response listing every problem.
+func (e fieldErrors) response(summary string) *pb.Dot1XConfigResponse {
+	parts := make([]string, len(e))
+	for i, fe := range e {
+		parts[i] = fe.Field + ": " + fe.Reason
+	}
+	return &pb.Dot1XConfigResponse{
+		Success:     false,
+		Message:     summary + ": " + strings.Join(parts, "; "),
+		FieldErrors: e,
+	}
+}
+
+// validateTLSCredentials checks the EAP-TLS credentials of a request at time now:
+// the CA and client certificates must parse (PEM or DER) and be within their
+// validity period, the client certificate must allow client authentication, and
+// the private key must decrypt with private_key_password and match the client
+// certificate.
+//
+// A PKCS#12 client identity is unpacked first. The returned request is the one to
+// apply: a copy with client_cert (including intermediates) and private_key taken
+// from the bundle, or req itself if it carries no bundle.
+func validateTLSCredentials(req *pb.Dot1XConfigRequest, now time.Time) (*pb.Dot1XConfigRequest, fieldErrors) {
+	var errs fieldErrors
+
+	certField, keyField, passwordField := "client_cert", "private_key", "private_key_password"
+	if len(req.Pkcs12) > 0 {
+		certField, keyField, passwordField = "pkcs12", "pkcs12", "pkcs12_password"
+		var err error
+		if req, err = expandPKCS12(req); err != nil {
+			if errors.Is(err, certs.ErrPKCS12Password) {
+				errs.add(passwordField, err.Error())
+			} else {
+				errs.add("pkcs12", err.Error())
+			}
+		}
+	}
+
+	if len(req.CaCert) > 0 {
+		checkCACert(&errs, "ca_cert", req.CaCert, now)
+	} else if req.CaPath == "" {
+		errs.add("ca_cert", "is required unless ca_path is given")
+	}
+	if len(errs) > 0 && len(req.Pkcs12) > 0 {
+		// The bundle could not be unpacked; there is nothing more to check
+		return req, errs
+	}
+
+	// The first certificate is the client's own; any others are intermediates
+	var leaf *x509.Certificate
+	if len(req.ClientCert) == 0 {
+		errs.add(certField, "is required")
+	} else if chain, err := certs.ParseCertificates(req.ClientCert); err != nil {
+		errs.add(certField, err.Error())
+	} else {
+		leaf = chain[0]
+		if err := certs.CheckValidity(leaf, now); err != nil {
+			errs.add(certField, fmt.Sprintf("%s: %v", leaf.Subject, err))
+		}
+		if err := certs.CheckClientAuth(leaf); err != nil {
+			errs.add(certField, fmt.Sprintf("%s: %v", leaf.Subject, err))
+		}
+	}
+
+	if len(req.PrivateKey) == 0 {
+		errs.add(keyField, "is required")
+	} else if key, err := certs.ParsePrivateKey(req.PrivateKey, req.PrivateKeyPassword); err != nil {
+		if errors.Is(err, certs.ErrPasswordRequired) || errors.Is(err, certs.ErrIncorrectPassword) {
+			errs.add(passwordField, err.Error())
+		} else {
+			errs.add(keyField, err.Error())
+		}
+	} else if leaf != nil {
+		if err := certs.CheckKeyPair(leaf, key); err != nil {
+			errs.add(keyField, err.Error())
+		}
+	}
+
+	return req, errs
+}
+
+// checkCACert checks that the CA certificates in a field parse and are valid at time now.
+func checkCACert(errs *fieldErrors, field string, data []byte, now time.Time) {
+	cas, err := certs.ParseCertificates(data)
+	if err != nil {
+		errs.add(field, err.Error())
+		return
+	}
+	for _, ca := range cas {
+		if err := certs.CheckValidity(ca, now); err != nil {
+			errs.add(field, fmt.Sprintf("%s: %v", ca.Subject, err))
+		}
+	}
+}
+
+// expandPKCS12 returns a copy of req with the client certificate chain and private
+// key unpacked from its PKCS#12 bundle. The bundle cannot be combined with
+// separate client_cert or private_key fields.
+func expandPKCS12(req *pb.Dot1XConfigRequest) (*pb.Dot1XConfigRequest, error) {
+	if len(req.ClientCert) > 0 || len(req.PrivateKey) > 0 {
+		return req, errors.New("cannot be combined with client_cert or private_key")
+	}
+	key, chain, err := certs.DecodePKCS12(req.Pkcs12, req.Pkcs12Password)
+	if err != nil {
+		return req, err
+	}
+	keyPEM, err := certs.EncodePrivateKey(key)
+	if err != nil {
+		return req, err
+	}
+
+	expanded := proto.Clone(req).(*pb.Dot1XConfigRequest)
+	expanded.ClientCert = certs.EncodeCertificates(chain)
+	expanded.PrivateKey = keyPEM
+	expanded.PrivateKeyPassword = ""
+	expanded.Pkcs12, expanded.Pkcs12Password = nil, ""
+	return expanded, nil
+}
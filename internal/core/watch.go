@@ -0,0 +1,143 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file implements status watching across all managed interfaces and the
+// notification plumbing shared by the watchers.
+package core
+
+import (
+	"context"
+	"log"
+	"sync"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// listeners fans out manager notifications (interfaces being added, wpa_supplicant
+// restarts) to the watchers that subscribed to them.
+type listeners[T any] struct {
+	mu     sync.Mutex
+	nextID int
+	subs   map[int]chan T
+}
+
+// subscribe registers a listener and returns its channel with a function that
+// removes the registration and closes the channel.
+func (l *listeners[T]) subscribe() (<-chan T, func()) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.subs == nil {
+		l.subs = make(map[int]chan T)
+	}
+	id := l.nextID
+	l.nextID++
+	ch := make(chan T, 16)
+	l.subs[id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			l.mu.Lock()
+			defer l.mu.Unlock()
+			delete(l.subs, id)
+			close(ch)
+		})
+	}
+}
+
+// notify delivers a notification to all listeners without blocking.
+func (l *listeners[T]) notify(v T) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, ch := range l.subs {
+		select {
+		case ch <- v:
+		default:
+			log.Printf("[WARN] Dropping notification %v: watcher is not keeping up", v)
+		}
+	}
+}
+
+// WatchAllStatus streams status updates for every managed interface, including
+// interfaces that become managed after the watch started. If filter is non-empty,
+// only the named interfaces are watched. Each interface starts with a snapshot,
+// as with WatchStatus. An interface whose watch ends, such as one no longer managed
+// after a wpa_supplicant restart, is watched again once it is managed again.
+//
+// The returned channel is closed when ctx is done.
+func (m *InterfaceManager) WatchAllStatus(ctx context.Context, filter []string) (<-chan *pb.InterfaceStatus, error) {
+	wanted := make(map[string]bool, len(filter))
+	for _, name := range filter {
+		wanted[name] = true
+	}
+	match := func(ifname string) bool {
+		return len(wanted) == 0 || wanted[ifname]
+	}
+
+	// Subscribe before listing so an interface added in between is not missed
+	added, unsubscribe := m.added.subscribe()
+	lifecycle, unsubscribeLifecycle := m.events.subscribe()
+
+	out := make(chan *pb.InterfaceStatus, 1)
+	ended := make(chan string)
+	var wg sync.WaitGroup
+	watching := make(map[string]bool) // Owned by the loop below once it starts
+
+	watch := func(ifname string) {
+		if watching[ifname] || !match(ifname) {
+			return
+		}
+		updates, err := m.WatchStatus(ctx, ifname)
+		if err != nil {
+			log.Printf("[WARN] Cannot watch %s: %v", ifname, err)
+			return
+		}
+		watching[ifname] = true
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for st := range updates {
+				if !sendStatus(ctx, out, st) {
+					return
+				}
+			}
+			// The interface watch ended on its own; let the loop watch it again
+			select {
+			case ended <- ifname:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	for _, ifname := range m.managedInterfaces() {
+		watch(ifname)
+	}
+
+	go func() {
+		defer close(out)
+		defer wg.Wait()
+		defer unsubscribe()
+		defer unsubscribeLifecycle()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case ifname, ok := <-added:
+				if !ok {
+					return
+				}
+				watch(ifname)
+			case ifname := <-ended:
+				delete(watching, ifname)
+				watch(ifname)
+			case _, ok := <-lifecycle:
+				if !ok {
+					return
+				}
+				// Interfaces whose watch ended while wpa_supplicant was away may be back
+				for _, ifname := range m.managedInterfaces() {
+					watch(ifname)
+				}
+			}
+		}
+	}()
+	return out, nil
+}
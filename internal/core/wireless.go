@@ -0,0 +1,173 @@
+// Package core provides the business logic for 802.1X authentication management.
+// This file configures WPA2- and WPA3-Enterprise wireless networks, which carry the
+// same EAP settings as wired ports, and reports the association of their interfaces.
+package core
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	godbus "github.com/godbus/dbus/v5"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// keyMgmtNames maps key management suites to their wpa_supplicant names.
+var keyMgmtNames = map[pb.KeyManagement]string{
+	pb.KeyManagement_KEY_MGMT_WPA_EAP:             "WPA-EAP",
+	pb.KeyManagement_KEY_MGMT_WPA_EAP_SHA256:      "WPA-EAP-SHA256",
+	pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192: "WPA-EAP-SUITE-B-192",
+}
+
+// pmfSettings maps protected management frame modes to wpa_supplicant ieee80211w values.
+var pmfSettings = map[pb.PmfMode]string{
+	pb.PmfMode_PMF_DISABLED: "0",
+	pb.PmfMode_PMF_OPTIONAL: "1",
+	pb.PmfMode_PMF_REQUIRED: "2",
+}
+
+// wifiBands are the channel frequency ranges in MHz of the 2.4, 5 and 6 GHz bands.
+var wifiBands = [][2]uint32{{2412, 2484}, {4910, 5885}, {5955, 7115}}
+
+// associatedStates are the supplicant states of an interface associated with an
+// access point.
+var associatedStates = []string{"associated", "4way_handshake", "group_handshake", "completed"}
+
+// keyMgmt returns the key management suites of a wireless request, defaulting to
+// WPA-EAP (WPA2-Enterprise).
+func keyMgmt(w *pb.WirelessConfig) []pb.KeyManagement {
+	if len(w.KeyMgmt) == 0 {
+		return []pb.KeyManagement{pb.KeyManagement_KEY_MGMT_WPA_EAP}
+	}
+	return w.KeyMgmt
+}
+
+// pmfMode returns the protected management frame mode of a wireless request.
+// Unless set, it is required when every suite needs it and optional when WPA-EAP
+// is offered alongside WPA-EAP-SHA256 (WPA3-Enterprise transition).
+func pmfMode(w *pb.WirelessConfig) pb.PmfMode {
+	if w.Pmf != pb.PmfMode_PMF_DEFAULT {
+		return w.Pmf
+	}
+	suites := keyMgmt(w)
+	if !slices.Contains(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP) {
+		return pb.PmfMode_PMF_REQUIRED
+	}
+	if slices.Contains(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP_SHA256) {
+		return pb.PmfMode_PMF_OPTIONAL
+	}
+	return pb.PmfMode_PMF_DEFAULT
+}
+
+// validateWireless checks the wireless settings of a request. WPA needs keys from
+// the EAP method, and WPA3-Enterprise 192-bit mode (SUITE-B-192) needs EAP-TLS and
+// protected management frames.
+func validateWireless(req *pb.Dot1XConfigRequest) fieldErrors {
+	var errs fieldErrors
+	w := req.GetWireless()
+	if w == nil {
+		return nil
+	}
+
+	if macsecMode(req) != pb.MacsecMode_MACSEC_MODE_DISABLED {
+		errs.add("macsec.mode", "MACsec is not supported on wireless networks")
+		return errs
+	}
+	if !derivesKeys(req.EapType) {
+		errs.add("eap_type", fmt.Sprintf("EAP-%s derives no keys for WPA", eapMethodName(req.EapType)))
+	}
+
+	if n := len(w.Ssid); n < 1 || n > 32 {
+		errs.add("wireless.ssid", fmt.Sprintf("must be 1 to 32 bytes, got %d", n))
+	}
+
+	suites := keyMgmt(w)
+	for _, k := range suites {
+		if _, ok := keyMgmtNames[k]; !ok {
+			errs.add("wireless.key_mgmt", fmt.Sprintf("%s is not a key management suite", k))
+		}
+	}
+	if slices.Contains(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192) {
+		switch {
+		case len(suites) > 1:
+			errs.add("wireless.key_mgmt", "KEY_MGMT_WPA_EAP_SUITE_B_192 cannot be combined with other suites")
+		case req.EapType != pb.EapType_EAP_TLS:
+			errs.add("eap_type", "KEY_MGMT_WPA_EAP_SUITE_B_192 requires EAP-TLS")
+		}
+	}
+	if pmf := pmfMode(w); pmf != pb.PmfMode_PMF_REQUIRED && !slices.Contains(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP) {
+		errs.add("wireless.pmf", fmt.Sprintf("%s needs PMF_REQUIRED", suites[0]))
+	} else if _, ok := pmfSettings[pmf]; !ok && pmf != pb.PmfMode_PMF_DEFAULT {
+		errs.add("wireless.pmf", fmt.Sprintf("unknown mode %d", pmf))
+	}
+
+	for _, freq := range w.Frequencies {
+		if !slices.ContainsFunc(wifiBands, func(b [2]uint32) bool { return freq >= b[0] && freq <= b[1] }) {
+			errs.add("wireless.frequencies", fmt.Sprintf("%d MHz is not a 2.4, 5 or 6 GHz channel", freq))
+		}
+	}
+	return errs
+}
+
+// wirelessConfig turns the wired network settings in cfg into those of a wireless
+// network: SSID, key management, protected management frames and frequencies.
+func wirelessConfig(req *pb.Dot1XConfigRequest, cfg map[string]string) {
+	w := req.GetWireless()
+	if w == nil {
+		return
+	}
+
+	suites := keyMgmt(w)
+	names := make([]string, len(suites))
+	for i, k := range suites {
+		names[i] = keyMgmtNames[k]
+	}
+	delete(cfg, "eapol_flags")
+	cfg["ssid"] = w.Ssid
+	cfg["key_mgmt"] = strings.Join(names, " ")
+	if v, ok := pmfSettings[pmfMode(w)]; ok {
+		cfg["ieee80211w"] = v
+	}
+	if w.ScanSsid {
+		cfg["scan_ssid"] = "1"
+	}
+	if len(w.Frequencies) > 0 {
+		freqs := make([]string, len(w.Frequencies))
+		for i, f := range w.Frequencies {
+			freqs[i] = strconv.FormatUint(uint64(f), 10)
+		}
+		cfg["freq_list"] = strings.Join(freqs, " ")
+	}
+
+	// 192-bit mode fixes the ciphers and restricts the TLS cipher suites
+	if slices.Contains(suites, pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192) {
+		cfg["pairwise"] = "GCMP-256"
+		cfg["group"] = "GCMP-256"
+		cfg["group_mgmt"] = "BIP-GMAC-256"
+		addPhase1(cfg, "tls_suiteb=1")
+	}
+}
+
+// wirelessStatus builds the wireless status of an interface configured for a
+// wireless network. The access point is omitted if it cannot be read.
+func (m *InterfaceManager) wirelessStatus(w *pb.WirelessConfig, st *dbus.InterfaceState) *pb.WirelessStatus {
+	status := &pb.WirelessStatus{
+		Ssid:       w.Ssid,
+		Associated: slices.Contains(associatedStates, st.State),
+	}
+	if st.CurrentBSS == "" || st.CurrentBSS == godbus.ObjectPath("/") {
+		return status
+	}
+	if bss, err := m.client.GetBSS(st.CurrentBSS); err == nil {
+		status.Bssid = bss.BSSID
+		status.Frequency = bss.Frequency
+		status.Signal = bss.Signal
+		if bss.SSID != "" {
+			status.Ssid = bss.SSID
+		}
+	}
+	return status
+}
@@ -0,0 +1,143 @@
+// Package credentials manages the files holding the certificates and private keys
+// that wpa_supplicant reads while authenticating. Files live in a private directory
+// owned by the service, with one subdirectory per interface.
+package credentials
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"syscall"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/fsutil"
+)
+
+// Permission of the credential directories
+const dirMode = 0700
+
+// ErrInvalidName is returned for interface or file names that could escape the
+// credential directory.
+var ErrInvalidName = fsutil.ErrInvalidName
+
+// DefaultRoot is the credential directory of the service. It lives in the runtime
+// directory dot1x.service declares, so it is private to the service and cleared on
+// reboot.
+const DefaultRoot = "/run/dot1x/credentials"
+
+// Dir is a directory of credential files. Each file is written under a unique name
+// in the subdirectory of its interface, so the files of a new network never replace
+// those still referenced by the network it supersedes.
+type Dir struct {
+	root      string
+	temporary bool // Root was created by OpenTempDir and is removed on Close
+}
+
+// OpenDir opens (creating if needed) the credential directory at root and restricts
+// it to mode 0700. A symlink or a directory owned by another user is rejected, so
+// credentials are never written to a directory planted by someone else. Nothing
+// references the files of a previous run, so any left by a crash are deleted.
+//
+// Returns an error if the directory cannot be created or secured.
+func OpenDir(root string) (*Dir, error) {
+	if err := os.MkdirAll(root, dirMode); err != nil {
+		return nil, fmt.Errorf("failed to create credential directory: %v", err)
+	}
+	info, err := os.Lstat(root)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inspect credential directory: %v", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("credential directory %s is not a directory", root)
+	}
+	if st, ok := info.Sys().(*syscall.Stat_t); !ok || int(st.Uid) != os.Geteuid() {
+		return nil, fmt.Errorf("credential directory %s is not owned by the service", root)
+	}
+	if err := os.Chmod(root, dirMode); err != nil {
+		return nil, fmt.Errorf("failed to secure credential directory: %v", err)
+	}
+	d := &Dir{root: root}
+	if err := d.Sweep(); err != nil {
+		return nil, fmt.Errorf("failed to remove orphaned credentials: %v", err)
+	}
+	return d, nil
+}
+
+// OpenTempDir creates a credential directory with a random name in the system
+// temporary directory. It is removed entirely on Close.
+func OpenTempDir() (*Dir, error) {
+	root, err := os.MkdirTemp("", "dot1x-credentials-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create credential directory: %v", err)
+	}
+	return &Dir{root: root, temporary: true}, nil
+}
+
+// Root returns the path of the credential directory.
+func (d *Dir) Root() string {
+	return d.root
+}
+
+// Write atomically stores content as a new credential file of an interface and
+// returns its path. name is used as the suffix of the file name (e.g. "ca.pem").
+func (d *Dir) Write(ifname, name string, content []byte) (string, error) {
+	if err := fsutil.ValidateName(ifname); err != nil {
+		return "", err
+	}
+	if err := fsutil.ValidateName(name); err != nil {
+		return "", err
+	}
+
+	dir := filepath.Join(d.root, ifname)
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return "", fmt.Errorf("failed to create credential directory: %v", err)
+	}
+
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", fmt.Errorf("failed to generate credential name: %v", err)
+	}
+	path := filepath.Join(dir, hex.EncodeToString(id)+"-"+name)
+	if err := fsutil.WriteAtomic(path, content); err != nil {
+		return "", err
+	}
+	return path, nil
+}
+
+// RemoveInterface deletes every credential file of an interface.
+func (d *Dir) RemoveInterface(ifname string) error {
+	if err := fsutil.ValidateName(ifname); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(d.root, ifname)); err != nil {
+		return fmt.Errorf("failed to remove credentials of %s: %v", ifname, err)
+	}
+	return nil
+}
+
+// Sweep deletes everything in the credential directory. It is used on open to
+// remove the files of a previous run that exited without cleaning up.
+func (d *Dir) Sweep() error {
+	entries, err := os.ReadDir(d.root)
+	if err != nil {
+		return fmt.Errorf("failed to read credential directory: %v", err)
+	}
+	var errs []error
+	for _, e := range entries {
+		if err := os.RemoveAll(filepath.Join(d.root, e.Name())); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Close deletes every credential file, and the directory itself if it was
+// created by OpenTempDir.
+func (d *Dir) Close() error {
+	if d.temporary {
+		return os.RemoveAll(d.root)
+	}
+	return d.Sweep()
+}
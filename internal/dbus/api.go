@@ -3,7 +3,126 @@
 // for managing network interfaces and authentication configurations.
 package dbus
 
-import "github.com/godbus/dbus/v5"
+import (
+	"errors"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// ErrInterfaceUnknown is returned when wpa_supplicant does not know the requested interface.
+var ErrInterfaceUnknown = errors.New("interface unknown to wpa_supplicant")
+
+// ErrNetworkUnknown is returned when wpa_supplicant does not know the requested network.
+var ErrNetworkUnknown = errors.New("network unknown to wpa_supplicant")
+
+// ErrBlobUnknown is returned when wpa_supplicant does not know the requested blob.
+var ErrBlobUnknown = errors.New("blob unknown to wpa_supplicant")
+
+// ErrNotConnected is returned when an interface must be connected to a network
+// for the requested operation.
+var ErrNotConnected = errors.New("interface not connected")
+
+// InterfaceState holds the state properties of a wpa_supplicant interface object
+// (fi.w1.wpa_supplicant1.Interface) that describe its authentication progress.
+type InterfaceState struct {
+	// Ifname is the name of the network interface.
+	Ifname string
+	// State is the raw wpa_supplicant state (e.g. "disconnected", "authenticating", "completed").
+	State string
+	// CurrentNetwork is the object path of the network in use, or "/" if none.
+	CurrentNetwork dbus.ObjectPath
+	// CurrentAuthMode is the authentication mode in use (e.g. "EAP-PEAP"), if any.
+	CurrentAuthMode string
+	// CurrentBSS is the object path of the access point a wireless interface is
+	// associated with, or "/" (or empty for wired interfaces) if none.
+	CurrentBSS dbus.ObjectPath
+	// Options are the settings the interface was created with.
+	Options InterfaceOptions
+}
+
+// InterfaceOptions are the settings of a wpa_supplicant interface that are fixed
+// when it is created, together with its EAPOL settings.
+type InterfaceOptions struct {
+	// Driver is the wpa_supplicant driver (e.g. "wired", or "macsec_linux" for MACsec).
+	Driver string
+	// BridgeIfname is the bridge the interface is a port of, if any. wpa_supplicant
+	// receives EAPOL frames on the bridge, as the kernel hands them to it.
+	BridgeIfname string
+	// ConfigFile is a wpa_supplicant configuration file read when the interface is
+	// created, if any.
+	ConfigFile string
+	// EapolVersion is the IEEE 802.1X version sent in EAPOL frames (1-3); 0 keeps
+	// wpa_supplicant's default.
+	EapolVersion uint32
+	// FastReauth enables EAP session resumption on reauthentication; nil keeps
+	// wpa_supplicant's default.
+	FastReauth *bool
+	// ApScan selects how wpa_supplicant scans and associates (0 for wired
+	// interfaces); nil keeps wpa_supplicant's default.
+	ApScan *uint32
+}
+
+// BSS describes an access point seen by a wireless interface
+// (fi.w1.wpa_supplicant1.BSS).
+type BSS struct {
+	// BSSID is the MAC address of the access point in colon-separated hex notation.
+	BSSID string
+	// SSID is the network name the access point advertises.
+	SSID string
+	// Frequency is the channel frequency in MHz.
+	Frequency uint32
+	// Signal is the received signal strength in dBm.
+	Signal int32
+}
+
+// EAPEvent is a single fi.w1.wpa_supplicant1.Interface.EAP signal.
+type EAPEvent struct {
+	// Status names the EAP step (e.g. "started", "method", "completion").
+	Status string
+	// Parameter qualifies the status (e.g. the method name, or "success"/"failure").
+	Parameter string
+}
+
+// NameOwnerChange is a NameOwnerChanged signal for the wpa_supplicant bus name.
+// An empty owner means the name had no owner, i.e. wpa_supplicant was not running.
+type NameOwnerChange struct {
+	OldOwner string
+	NewOwner string
+}
+
+// Stopped reports whether the previous wpa_supplicant instance left the bus,
+// taking all of its interface and network objects with it.
+func (c NameOwnerChange) Stopped() bool {
+	return c.OldOwner != ""
+}
+
+// Started reports whether a (new) wpa_supplicant instance took the bus name.
+func (c NameOwnerChange) Started() bool {
+	return c.NewOwner != ""
+}
+
+// ApplyChanges updates the state with the values from a PropertiesChanged signal.
+// Returns true if any of the tracked properties changed value.
+func (s *InterfaceState) ApplyChanges(changed map[string]dbus.Variant) bool {
+	updated := false
+	if v, ok := changed["State"].Value().(string); ok && v != s.State {
+		s.State = v
+		updated = true
+	}
+	if v, ok := changed["CurrentNetwork"].Value().(dbus.ObjectPath); ok && v != s.CurrentNetwork {
+		s.CurrentNetwork = v
+		updated = true
+	}
+	if v, ok := changed["CurrentAuthMode"].Value().(string); ok && v != s.CurrentAuthMode {
+		s.CurrentAuthMode = v
+		updated = true
+	}
+	if v, ok := changed["CurrentBSS"].Value().(dbus.ObjectPath); ok && v != s.CurrentBSS {
+		s.CurrentBSS = v
+		updated = true
+	}
+	return updated
+}
 
 // SupplicantAPI defines the interface for interacting with wpa_supplicant via D-Bus.
 // This interface abstracts the D-Bus operations needed to manage 802.1X authentication
@@ -11,15 +130,18 @@ import "github.com/godbus/dbus/v5"
 //
 // The interface includes methods for:
 //   - Interface management (create, remove, lookup)
-//   - Network configuration (add, select, disconnect)
+//   - Network configuration (add, select, remove, list, disconnect)
+//   - State inspection (interface and access point properties, supported EAP methods)
+//   - Signal subscriptions (property changes, EAP events, service restarts)
 //   - Resource cleanup (close connection)
 //
 // Implementations of this interface should handle the low-level D-Bus communication
 // with wpa_supplicant, converting between Go types and D-Bus variants as needed.
 type SupplicantAPI interface {
-	// CreateInterface creates a new network interface in wpa_supplicant.
+	// CreateInterface creates a new network interface in wpa_supplicant with the given
+	// driver, bridge, configuration file and EAPOL settings.
 	// Returns the D-Bus object path of the created interface.
-	CreateInterface(ifname string) (dbus.ObjectPath, error)
+	CreateInterface(ifname string, opts InterfaceOptions) (dbus.ObjectPath, error)
 
 	// RemoveInterface removes a network interface from wpa_supplicant.
 	// This disconnects the interface and cleans up associated resources.
@@ -38,10 +160,66 @@ type SupplicantAPI interface {
 	// This tells wpa_supplicant to attempt authentication using the specified configuration.
 	SelectNetwork(ifacePath, networkPath dbus.ObjectPath) error
 
+	// RemoveNetwork removes a network configuration from a wpa_supplicant interface.
+	// Returns ErrNetworkUnknown if the network no longer exists.
+	RemoveNetwork(ifacePath, networkPath dbus.ObjectPath) error
+
+	// RemoveAllNetworks removes every network configuration from a wpa_supplicant interface.
+	RemoveAllNetworks(ifacePath dbus.ObjectPath) error
+
+	// ListNetworks returns the D-Bus object paths of the networks configured on an interface.
+	ListNetworks(ifacePath dbus.ObjectPath) ([]dbus.ObjectPath, error)
+
+	// AddBlob stores a named binary blob (e.g. a certificate) in memory on a
+	// wpa_supplicant interface. Networks reference it as "blob://<name>".
+	AddBlob(ifacePath dbus.ObjectPath, name string, data []byte) error
+
+	// RemoveBlob removes a named blob from a wpa_supplicant interface.
+	// Returns ErrBlobUnknown if the blob no longer exists.
+	RemoveBlob(ifacePath dbus.ObjectPath, name string) error
+
 	// DisconnectNetwork disconnects the current network on an interface.
 	// This terminates the 802.1X authentication session.
 	DisconnectNetwork(ifacePath dbus.ObjectPath) error
 
+	// Reauthenticate starts a new EAP authentication on the current network of an
+	// interface, keeping its configuration.
+	// Returns ErrNotConnected if the interface is disconnected.
+	Reauthenticate(ifacePath dbus.ObjectPath) error
+
+	// EAPLogoff sends EAPOL-Logoff on an interface, which then stays unauthenticated
+	// until EAPLogon is called.
+	EAPLogoff(ifacePath dbus.ObjectPath) error
+
+	// EAPLogon lets an interface logged off with EAPLogoff authenticate again.
+	EAPLogon(ifacePath dbus.ObjectPath) error
+
+	// GetInterfaceState reads the current state properties of a wpa_supplicant interface.
+	// Returns ErrInterfaceUnknown if wpa_supplicant no longer knows the object path.
+	GetInterfaceState(ifacePath dbus.ObjectPath) (*InterfaceState, error)
+
+	// GetBSS reads the properties of an access point seen by a wireless interface.
+	GetBSS(bssPath dbus.ObjectPath) (*BSS, error)
+
+	// EapMethods returns the EAP methods compiled into wpa_supplicant (e.g. "MD5",
+	// "PEAP", "TEAP"), as reported by its EapMethods property.
+	EapMethods() ([]string, error)
+
+	// SubscribePropertiesChanged subscribes to property change signals of a wpa_supplicant
+	// interface. Each map received on the channel holds only the properties that changed.
+	// The returned cancel function ends the subscription and closes the channel.
+	SubscribePropertiesChanged(ifacePath dbus.ObjectPath) (<-chan map[string]dbus.Variant, func(), error)
+
+	// SubscribeEAP subscribes to the EAP signal of a wpa_supplicant interface, which reports
+	// progress of the EAP authentication. The returned cancel function ends the subscription
+	// and closes the channel.
+	SubscribeEAP(ifacePath dbus.ObjectPath) (<-chan EAPEvent, func(), error)
+
+	// SubscribeNameOwnerChanged subscribes to ownership changes of the wpa_supplicant bus
+	// name, which report wpa_supplicant exiting or (re)starting. The returned cancel
+	// function ends the subscription and closes the channel.
+	SubscribeNameOwnerChanged() (<-chan NameOwnerChange, func(), error)
+
 	// Close closes the D-Bus connection and releases associated resources.
 	// This method should be called when the client is no longer needed.
 	Close()
@@ -0,0 +1,120 @@
+// Package dbus provides D-Bus communication with wpa_supplicant for 802.1X authentication.
+// This file implements the signal routing used to deliver wpa_supplicant D-Bus
+// signals (property changes, EAP events, etc.) to individual subscribers.
+package dbus
+
+import (
+	"log"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+)
+
+// signalBuffer is the number of signals queued per subscriber before new
+// signals are dropped. Subscribers are expected to drain their channel promptly.
+const signalBuffer = 32
+
+// signalRouter fans out signals received on a single D-Bus connection to the
+// subscribers that registered interest in a given object path and signal name.
+type signalRouter struct {
+	mu     sync.Mutex
+	nextID uint64
+	subs   map[uint64]*signalSub
+	closed bool
+}
+
+// signalSub is a single registration with the signal router.
+type signalSub struct {
+	path dbus.ObjectPath // Object path to match; empty matches any path
+	name string          // Fully qualified signal name ("interface.member")
+	ch   chan *dbus.Signal
+}
+
+// newSignalRouter creates an empty signal router.
+func newSignalRouter() *signalRouter {
+	return &signalRouter{subs: make(map[uint64]*signalSub)}
+}
+
+// run dispatches signals from the connection channel until it is closed,
+// after which all subscriber channels are closed as well.
+func (r *signalRouter) run(signals <-chan *dbus.Signal) {
+	for sig := range signals {
+		r.dispatch(sig)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	for id, sub := range r.subs {
+		close(sub.ch)
+		delete(r.subs, id)
+	}
+}
+
+// dispatch delivers a signal to every matching subscriber without blocking.
+// A subscriber whose buffer is full misses the signal.
+func (r *signalRouter) dispatch(sig *dbus.Signal) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, sub := range r.subs {
+		if sub.name != sig.Name || (sub.path != "" && sub.path != sig.Path) {
+			continue
+		}
+		select {
+		case sub.ch <- sig:
+		default:
+			log.Printf("[WARN] Dropping D-Bus signal %s on %s: subscriber is not keeping up", sig.Name, sig.Path)
+		}
+	}
+}
+
+// subscribe registers a subscriber for the named signal on the given path and
+// returns its channel together with a function that removes the registration.
+func (r *signalRouter) subscribe(path dbus.ObjectPath, name string) (<-chan *dbus.Signal, func()) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sub := &signalSub{path: path, name: name, ch: make(chan *dbus.Signal, signalBuffer)}
+	if r.closed {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+
+	id := r.nextID
+	r.nextID++
+	r.subs[id] = sub
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+			if _, ok := r.subs[id]; ok {
+				close(sub.ch)
+				delete(r.subs, id)
+			}
+		})
+	}
+}
+
+// relaySignals converts raw signals into typed values using convert, skipping
+// signals for which convert returns false. The returned channel is closed once
+// the raw channel is closed, which happens when the subscription is canceled.
+func relaySignals[T any](raw <-chan *dbus.Signal, convert func(*dbus.Signal) (T, bool)) <-chan T {
+	out := make(chan T, signalBuffer)
+	go func() {
+		defer close(out)
+		for sig := range raw {
+			v, ok := convert(sig)
+			if !ok {
+				continue
+			}
+			select {
+			case out <- v:
+			default:
+				log.Printf("[WARN] Dropping D-Bus signal %s on %s: subscriber is not keeping up", sig.Name, sig.Path)
+			}
+		}
+	}()
+	return out
+}
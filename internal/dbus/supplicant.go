@@ -4,7 +4,11 @@
 package dbus
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"net"
+	"strconv"
 
 	"github.com/godbus/dbus/v5"
 )
@@ -17,14 +21,51 @@ const supplicantPath = dbus.ObjectPath("/fi/w1/wpa_supplicant1")
 const (
 	interfaceInterface = supplicantInterface + ".Interface"
 	networkInterface   = supplicantInterface + ".Network"
+	bssInterface       = supplicantInterface + ".BSS"
 )
 
+// Standard D-Bus interfaces and error names used when inspecting objects
+const (
+	busName             = "org.freedesktop.DBus"
+	busPath             = dbus.ObjectPath("/org/freedesktop/DBus")
+	busInterface        = "org.freedesktop.DBus"
+	propertiesInterface = "org.freedesktop.DBus.Properties"
+	errUnknownObject    = "org.freedesktop.DBus.Error.UnknownObject"
+	errUnknownMethod    = "org.freedesktop.DBus.Error.UnknownMethod"
+	errInterfaceUnknown = supplicantInterface + ".InterfaceUnknown"
+	errNetworkUnknown   = supplicantInterface + ".NetworkUnknown"
+	errBlobUnknown      = supplicantInterface + ".BlobUnknown"
+	errNotConnected     = supplicantInterface + ".NotConnected"
+)
+
+// hexNetworkFields are network fields wpa_supplicant parses as unquoted hex. It
+// quotes string values set over D-Bus, so these are sent as byte arrays, which it
+// hex-encodes instead.
+var hexNetworkFields = map[string]bool{
+	"mka_cak": true,
+	"mka_ckn": true,
+}
+
+// intNetworkFields are network fields wpa_supplicant parses as integers. Quoted,
+// their values are rejected, so these are sent as int32 variants instead.
+var intNetworkFields = map[string]bool{
+	"eapol_flags":       true,
+	"macsec_policy":     true,
+	"macsec_integ_only": true,
+	"mka_priority":      true,
+	"macsec_port":       true,
+	"priority":          true,
+	"ieee80211w":        true,
+	"scan_ssid":         true,
+}
+
 // SupplicantClient provides D-Bus communication with wpa_supplicant.
 // It handles the creation and management of network interfaces, configuration
 // of authentication parameters, and monitoring of connection status.
 type SupplicantClient struct {
-	conn *dbus.Conn
-	obj  dbus.BusObject
+	conn    *dbus.Conn
+	obj     dbus.BusObject
+	signals *signalRouter
 }
 
 // NewSupplicantClient creates a new SupplicantClient instance and establishes
@@ -37,29 +78,70 @@ func NewSupplicantClient() (*SupplicantClient, error) {
 		return nil, fmt.Errorf("failed to connect to system bus: %v", err)
 	}
 	obj := conn.Object(supplicantInterface, supplicantPath)
-	return &SupplicantClient{conn: conn, obj: obj}, nil
+
+	// Route all signals received on the connection through a single dispatcher
+	signals := make(chan *dbus.Signal, signalBuffer)
+	conn.Signal(signals)
+	router := newSignalRouter()
+	go router.run(signals)
+
+	return &SupplicantClient{conn: conn, obj: obj, signals: router}, nil
 }
 
 // CreateInterface creates a new network interface in wpa_supplicant for the specified
-// interface name. The interface is configured for wired (Ethernet) connections.
+// interface name.
 //
 // The method sets up the interface with:
 //   - Interface name (ifname)
-//   - Driver type (wired)
-//   - Empty config file (configuration will be added via D-Bus)
+//   - Driver type ("wired" for plain 802.1X, "macsec_linux" for MACsec)
+//   - Bridge interface, if the port is a bridge member
+//   - Config file, empty unless given (configuration is added via D-Bus)
+//
+// The EAPOL settings are then set as interface properties; if that fails the
+// interface is removed again.
 //
 // Returns the D-Bus object path of the created interface or an error.
-func (s *SupplicantClient) CreateInterface(ifname string) (dbus.ObjectPath, error) {
+func (s *SupplicantClient) CreateInterface(ifname string, opts InterfaceOptions) (dbus.ObjectPath, error) {
 	props := map[string]dbus.Variant{
 		"Ifname":     dbus.MakeVariant(ifname),
-		"Driver":     dbus.MakeVariant("wired"),
-		"ConfigFile": dbus.MakeVariant(""),
+		"ConfigFile": dbus.MakeVariant(opts.ConfigFile),
+	}
+	if opts.Driver != "" {
+		props["Driver"] = dbus.MakeVariant(opts.Driver)
+	}
+	if opts.BridgeIfname != "" {
+		props["BridgeIfname"] = dbus.MakeVariant(opts.BridgeIfname)
 	}
 	var path dbus.ObjectPath
 	err := s.obj.Call(supplicantInterface+".CreateInterface", 0, props).Store(&path)
 	if err != nil {
 		return "", fmt.Errorf("CreateInterface failed: %v", err)
 	}
+
+	// EAPOL settings are interface properties rather than creation arguments
+	obj := s.conn.Object(supplicantInterface, path)
+	set := func(name string, value interface{}) error {
+		if err := obj.SetProperty(interfaceInterface+"."+name, dbus.MakeVariant(value)); err != nil {
+			s.RemoveInterface(path)
+			return fmt.Errorf("setting %s failed: %v", name, err)
+		}
+		return nil
+	}
+	if opts.EapolVersion != 0 {
+		if err := set("EapolVersion", strconv.FormatUint(uint64(opts.EapolVersion), 10)); err != nil {
+			return "", err
+		}
+	}
+	if opts.FastReauth != nil {
+		if err := set("FastReauth", *opts.FastReauth); err != nil {
+			return "", err
+		}
+	}
+	if opts.ApScan != nil {
+		if err := set("ApScan", *opts.ApScan); err != nil {
+			return "", err
+		}
+	}
 	return path, nil
 }
 
@@ -102,18 +184,33 @@ func (s *SupplicantClient) GetInterfacePathByName(ifname string) (dbus.ObjectPat
 // Returns the D-Bus object path of the created network or an error.
 func (s *SupplicantClient) AddNetwork(ifacePath dbus.ObjectPath, config map[string]string) (dbus.ObjectPath, error) {
 	obj := s.conn.Object(supplicantInterface, ifacePath)
-	networkProps := make(map[string]dbus.Variant)
-	for k, v := range config {
-		networkProps[k] = dbus.MakeVariant(v)
-	}
 	var netPath dbus.ObjectPath
-	err := obj.Call(interfaceInterface+".AddNetwork", 0, networkProps).Store(&netPath)
+	err := obj.Call(interfaceInterface+".AddNetwork", 0, NetworkProperties(config)).Store(&netPath)
 	if err != nil {
 		return "", fmt.Errorf("AddNetwork failed: %v", err)
 	}
 	return netPath, nil
 }
 
+// NetworkProperties converts a network configuration to the D-Bus variants of an
+// AddNetwork call. Values are sent as strings, which wpa_supplicant quotes, except
+// for the hex and integer fields it parses unquoted.
+func NetworkProperties(config map[string]string) map[string]dbus.Variant {
+	props := make(map[string]dbus.Variant, len(config))
+	for k, v := range config {
+		if b, err := hex.DecodeString(v); err == nil && hexNetworkFields[k] {
+			props[k] = dbus.MakeVariant(b)
+			continue
+		}
+		if n, err := strconv.ParseInt(v, 10, 32); err == nil && intNetworkFields[k] {
+			props[k] = dbus.MakeVariant(int32(n))
+			continue
+		}
+		props[k] = dbus.MakeVariant(v)
+	}
+	return props
+}
+
 // SelectNetwork activates a network configuration on an interface.
 // This method tells wpa_supplicant to attempt authentication using
 // the specified network configuration.
@@ -124,6 +221,116 @@ func (s *SupplicantClient) SelectNetwork(ifacePath, networkPath dbus.ObjectPath)
 	return obj.Call(interfaceInterface+".SelectNetwork", 0, networkPath).Err
 }
 
+// RemoveNetwork removes a network configuration from a wpa_supplicant interface.
+// If the network is currently in use, wpa_supplicant disconnects from it first.
+//
+// Returns ErrNetworkUnknown if the network does not exist, or another error if the removal fails.
+func (s *SupplicantClient) RemoveNetwork(ifacePath, networkPath dbus.ObjectPath) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	err := obj.Call(interfaceInterface+".RemoveNetwork", 0, networkPath).Err
+	if err != nil {
+		if dbusErrorName(err) == errNetworkUnknown {
+			return fmt.Errorf("%w: %s", ErrNetworkUnknown, networkPath)
+		}
+		return fmt.Errorf("RemoveNetwork failed: %v", err)
+	}
+	return nil
+}
+
+// RemoveAllNetworks removes every network configuration from a wpa_supplicant interface.
+//
+// Returns an error if the removal fails.
+func (s *SupplicantClient) RemoveAllNetworks(ifacePath dbus.ObjectPath) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	if err := obj.Call(interfaceInterface+".RemoveAllNetworks", 0).Err; err != nil {
+		return fmt.Errorf("RemoveAllNetworks failed: %v", err)
+	}
+	return nil
+}
+
+// ListNetworks returns the object paths of the networks configured on an interface,
+// as reported by its Networks property.
+//
+// Returns ErrInterfaceUnknown if the interface no longer exists.
+func (s *SupplicantClient) ListNetworks(ifacePath dbus.ObjectPath) ([]dbus.ObjectPath, error) {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	prop, err := obj.GetProperty(interfaceInterface + ".Networks")
+	if err != nil {
+		if isUnknownObject(err) {
+			return nil, fmt.Errorf("%w: %s", ErrInterfaceUnknown, ifacePath)
+		}
+		return nil, fmt.Errorf("reading Networks failed: %v", err)
+	}
+	paths, ok := prop.Value().([]dbus.ObjectPath)
+	if !ok {
+		return nil, fmt.Errorf("unexpected Networks property type %s", prop.Signature())
+	}
+	return paths, nil
+}
+
+// GetBSS reads the properties of an access point using a single
+// org.freedesktop.DBus.Properties.GetAll call.
+func (s *SupplicantClient) GetBSS(bssPath dbus.ObjectPath) (*BSS, error) {
+	obj := s.conn.Object(supplicantInterface, bssPath)
+	var props map[string]dbus.Variant
+	if err := obj.Call(propertiesInterface+".GetAll", 0, bssInterface).Store(&props); err != nil {
+		return nil, fmt.Errorf("reading BSS %s failed: %v", bssPath, err)
+	}
+
+	bss := &BSS{}
+	if v, ok := props["BSSID"].Value().([]byte); ok {
+		bss.BSSID = net.HardwareAddr(v).String()
+	}
+	if v, ok := props["SSID"].Value().([]byte); ok {
+		bss.SSID = string(v)
+	}
+	if v, ok := props["Frequency"].Value().(uint16); ok {
+		bss.Frequency = uint32(v)
+	}
+	if v, ok := props["Signal"].Value().(int16); ok {
+		bss.Signal = int32(v)
+	}
+	return bss, nil
+}
+
+// EapMethods returns the EAP methods supported by the running wpa_supplicant build,
+// as reported by the EapMethods property of its root object.
+func (s *SupplicantClient) EapMethods() ([]string, error) {
+	obj := s.conn.Object(supplicantInterface, supplicantPath)
+	prop, err := obj.GetProperty(supplicantInterface + ".EapMethods")
+	if err != nil {
+		return nil, fmt.Errorf("reading EapMethods failed: %v", err)
+	}
+	methods, ok := prop.Value().([]string)
+	if !ok {
+		return nil, fmt.Errorf("unexpected EapMethods property type %s", prop.Signature())
+	}
+	return methods, nil
+}
+
+// AddBlob stores a named blob on a wpa_supplicant interface. Blobs live in
+// wpa_supplicant's memory only and are lost when it exits.
+func (s *SupplicantClient) AddBlob(ifacePath dbus.ObjectPath, name string, data []byte) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	if err := obj.Call(interfaceInterface+".AddBlob", 0, name, data).Err; err != nil {
+		return fmt.Errorf("AddBlob failed: %v", err)
+	}
+	return nil
+}
+
+// RemoveBlob removes a named blob from a wpa_supplicant interface.
+func (s *SupplicantClient) RemoveBlob(ifacePath dbus.ObjectPath, name string) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	err := obj.Call(interfaceInterface+".RemoveBlob", 0, name).Err
+	if err != nil {
+		if dbusErrorName(err) == errBlobUnknown {
+			return fmt.Errorf("%w: %s", ErrBlobUnknown, name)
+		}
+		return fmt.Errorf("RemoveBlob failed: %v", err)
+	}
+	return nil
+}
+
 // DisconnectNetwork disconnects the current network on an interface.
 // This method terminates the 802.1X authentication session and
 // disconnects from the network.
@@ -134,6 +341,210 @@ func (s *SupplicantClient) DisconnectNetwork(ifacePath dbus.ObjectPath) error {
 	return obj.Call(interfaceInterface+".Disconnect", 0).Err
 }
 
+// Reauthenticate starts a new EAP authentication on the current network of an
+// interface, as wpa_cli's "reauthenticate" does.
+//
+// Returns ErrNotConnected if the interface is disconnected.
+func (s *SupplicantClient) Reauthenticate(ifacePath dbus.ObjectPath) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	err := obj.Call(interfaceInterface+".Reauthenticate", 0).Err
+	if err != nil {
+		if dbusErrorName(err) == errNotConnected {
+			return fmt.Errorf("%w: %s", ErrNotConnected, ifacePath)
+		}
+		return fmt.Errorf("Reauthenticate failed: %v", err)
+	}
+	return nil
+}
+
+// EAPLogoff sends EAPOL-Logoff on an interface. wpa_supplicant does not start
+// another authentication on it until EAPLogon is called.
+func (s *SupplicantClient) EAPLogoff(ifacePath dbus.ObjectPath) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	if err := obj.Call(interfaceInterface+".EAPLogoff", 0).Err; err != nil {
+		return fmt.Errorf("EAPLogoff failed: %v", err)
+	}
+	return nil
+}
+
+// EAPLogon ends the logoff started by EAPLogoff, so the interface authenticates
+// again.
+func (s *SupplicantClient) EAPLogon(ifacePath dbus.ObjectPath) error {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	if err := obj.Call(interfaceInterface+".EAPLogon", 0).Err; err != nil {
+		return fmt.Errorf("EAPLogon failed: %v", err)
+	}
+	return nil
+}
+
+// GetInterfaceState reads the state properties of a wpa_supplicant interface
+// using a single org.freedesktop.DBus.Properties.GetAll call.
+//
+// Returns ErrInterfaceUnknown if the object path no longer exists in wpa_supplicant.
+func (s *SupplicantClient) GetInterfaceState(ifacePath dbus.ObjectPath) (*InterfaceState, error) {
+	obj := s.conn.Object(supplicantInterface, ifacePath)
+	var props map[string]dbus.Variant
+	err := obj.Call(propertiesInterface+".GetAll", 0, interfaceInterface).Store(&props)
+	if err != nil {
+		if isUnknownObject(err) {
+			return nil, fmt.Errorf("%w: %s", ErrInterfaceUnknown, ifacePath)
+		}
+		return nil, fmt.Errorf("GetAll failed: %v", err)
+	}
+
+	state := &InterfaceState{CurrentNetwork: "/"}
+	if v, ok := props["Ifname"].Value().(string); ok {
+		state.Ifname = v
+	}
+	state.Options = interfaceOptions(props)
+	state.ApplyChanges(props)
+	return state, nil
+}
+
+// interfaceOptions reads the creation and EAPOL settings of an interface from its
+// properties. Properties missing from older wpa_supplicant versions are left unset.
+func interfaceOptions(props map[string]dbus.Variant) InterfaceOptions {
+	var opts InterfaceOptions
+	opts.Driver, _ = props["Driver"].Value().(string)
+	opts.BridgeIfname, _ = props["BridgeIfname"].Value().(string)
+	opts.ConfigFile, _ = props["ConfigFile"].Value().(string)
+	if v, ok := props["EapolVersion"].Value().(string); ok {
+		if n, err := strconv.ParseUint(v, 10, 32); err == nil {
+			opts.EapolVersion = uint32(n)
+		}
+	}
+	if v, ok := props["FastReauth"].Value().(bool); ok {
+		opts.FastReauth = &v
+	}
+	if v, ok := props["ApScan"].Value().(uint32); ok {
+		opts.ApScan = &v
+	}
+	return opts
+}
+
+// SubscribePropertiesChanged subscribes to org.freedesktop.DBus.Properties.PropertiesChanged
+// signals emitted by wpa_supplicant for the given interface object. Each map received on
+// the channel contains only the fi.w1.wpa_supplicant1.Interface properties that changed.
+//
+// The returned cancel function removes the bus match rule and closes the channel.
+func (s *SupplicantClient) SubscribePropertiesChanged(ifacePath dbus.ObjectPath) (<-chan map[string]dbus.Variant, func(), error) {
+	raw, cancel, err := s.watchSignal(supplicantInterface, ifacePath, propertiesInterface, "PropertiesChanged",
+		dbus.WithMatchArg(0, interfaceInterface))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := relaySignals(raw, func(sig *dbus.Signal) (map[string]dbus.Variant, bool) {
+		// Body: (s interface_name, a{sv} changed_properties, as invalidated_properties)
+		if len(sig.Body) < 2 {
+			return nil, false
+		}
+		if name, _ := sig.Body[0].(string); name != interfaceInterface {
+			return nil, false
+		}
+		changed, ok := sig.Body[1].(map[string]dbus.Variant)
+		return changed, ok && len(changed) > 0
+	})
+	return out, cancel, nil
+}
+
+// SubscribeEAP subscribes to fi.w1.wpa_supplicant1.Interface.EAP signals emitted for the
+// given interface object. Each signal carries an EAP status and an optional parameter.
+//
+// The returned cancel function removes the bus match rule and closes the channel.
+func (s *SupplicantClient) SubscribeEAP(ifacePath dbus.ObjectPath) (<-chan EAPEvent, func(), error) {
+	raw, cancel, err := s.watchSignal(supplicantInterface, ifacePath, interfaceInterface, "EAP")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := relaySignals(raw, func(sig *dbus.Signal) (EAPEvent, bool) {
+		// Body: (s status, s parameter)
+		if len(sig.Body) < 2 {
+			return EAPEvent{}, false
+		}
+		status, ok1 := sig.Body[0].(string)
+		param, ok2 := sig.Body[1].(string)
+		return EAPEvent{Status: status, Parameter: param}, ok1 && ok2
+	})
+	return out, cancel, nil
+}
+
+// SubscribeNameOwnerChanged subscribes to org.freedesktop.DBus.NameOwnerChanged signals
+// for the fi.w1.wpa_supplicant1 bus name, which report wpa_supplicant starting, exiting
+// or being replaced. All interface and network object paths are invalidated when
+// wpa_supplicant exits.
+//
+// The returned cancel function removes the bus match rule and closes the channel.
+func (s *SupplicantClient) SubscribeNameOwnerChanged() (<-chan NameOwnerChange, func(), error) {
+	raw, cancel, err := s.watchSignal(busName, busPath, busInterface, "NameOwnerChanged",
+		dbus.WithMatchArg(0, supplicantInterface))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := relaySignals(raw, func(sig *dbus.Signal) (NameOwnerChange, bool) {
+		// Body: (s name, s old_owner, s new_owner)
+		if len(sig.Body) < 3 {
+			return NameOwnerChange{}, false
+		}
+		name, _ := sig.Body[0].(string)
+		oldOwner, ok1 := sig.Body[1].(string)
+		newOwner, ok2 := sig.Body[2].(string)
+		return NameOwnerChange{OldOwner: oldOwner, NewOwner: newOwner}, name == supplicantInterface && ok1 && ok2
+	})
+	return out, cancel, nil
+}
+
+// watchSignal adds a bus match rule for a signal from the given sender and registers a
+// subscriber for it with the signal router. An empty path matches signals from any object.
+//
+// The returned cancel function removes both the match rule and the subscriber.
+func (s *SupplicantClient) watchSignal(sender string, path dbus.ObjectPath, iface, member string, extra ...dbus.MatchOption) (<-chan *dbus.Signal, func(), error) {
+	opts := []dbus.MatchOption{
+		dbus.WithMatchSender(sender),
+		dbus.WithMatchInterface(iface),
+		dbus.WithMatchMember(member),
+	}
+	if path != "" {
+		opts = append(opts, dbus.WithMatchObjectPath(path))
+	}
+	opts = append(opts, extra...)
+
+	if err := s.conn.AddMatchSignal(opts...); err != nil {
+		return nil, nil, fmt.Errorf("AddMatch %s.%s failed: %v", iface, member, err)
+	}
+	ch, unsubscribe := s.signals.subscribe(path, iface+"."+member)
+	return ch, func() {
+		unsubscribe()
+		s.conn.RemoveMatchSignal(opts...)
+	}, nil
+}
+
+// isUnknownObject reports whether a D-Bus call failed because the target
+// object (or the wpa_supplicant interface behind it) does not exist.
+func isUnknownObject(err error) bool {
+	switch dbusErrorName(err) {
+	case errUnknownObject, errUnknownMethod, errInterfaceUnknown:
+		return true
+	}
+	return false
+}
+
+// dbusErrorName returns the D-Bus error name carried by err, or "" if err is
+// not a D-Bus error reply.
+func dbusErrorName(err error) string {
+	var dbusErr dbus.Error
+	var dbusErrPtr *dbus.Error
+	switch {
+	case errors.As(err, &dbusErr):
+		return dbusErr.Name
+	case errors.As(err, &dbusErrPtr):
+		return dbusErrPtr.Name
+	}
+	return ""
+}
+
 // RawConnection returns the underlying D-Bus connection object.
 // This method is primarily used for testing and advanced D-Bus operations
 // that require direct access to the connection.
@@ -0,0 +1,54 @@
+// Package fsutil provides the file helpers shared by the directories the service
+// keeps on disk: the desired-state store, credential files and EAP-FAST PACs.
+package fsutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FileMode is the permission of the files written by WriteAtomic.
+const FileMode = 0600
+
+// ErrInvalidName is returned for interface or file names that could escape their
+// directory.
+var ErrInvalidName = errors.New("invalid name")
+
+// WriteAtomic writes data with mode 0600 to a temporary file in the same directory
+// and renames it over path, so readers (including wpa_supplicant) never observe a
+// partially written file.
+func WriteAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file: %v", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(FileMode); err != nil {
+		tmp.Close()
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %v", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// ValidateName rejects names that are empty or could escape their directory.
+func ValidateName(name string) error {
+	if name == "" || name == "." || name == ".." || strings.ContainsAny(name, "/\x00") {
+		return fmt.Errorf("%w: %q", ErrInvalidName, name)
+	}
+	return nil
+}
@@ -5,9 +5,14 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/gavmckee80/dot1x-grpc/internal/core"
 	pb "github.com/gavmckee80/dot1x-grpc/proto"
 )
@@ -22,10 +27,11 @@ type Dot1xService struct {
 
 // NewDot1xService creates a new Dot1xService instance with a default
 // InterfaceManager. This is the primary constructor for production use.
+// The options are passed on to the InterfaceManager.
 //
 // Panics if the InterfaceManager cannot be created (e.g., D-Bus connection failure).
-func NewDot1xService() *Dot1xService {
-	manager, err := core.NewInterfaceManager()
+func NewDot1xService(opts ...core.Option) *Dot1xService {
+	manager, err := core.NewInterfaceManager(opts...)
 	if err != nil {
 		log.Fatalf("Failed to create interface manager: %v", err)
 	}
@@ -47,8 +53,12 @@ func NewDot1xServiceWithManager(m *core.InterfaceManager) *Dot1xService {
 //   - EAP-TTLS: Tunneled TLS with inner authentication
 //   - EAP-TLS: Certificate-based authentication
 //   - EAP-FAST: Flexible Authentication via Secure Tunneling
+//   - EAP-TEAP: Tunnel Extensible Authentication Protocol
+//   - EAP-PWD, EAP-MD5, EAP-GTC: Password-only methods
 //
-// Returns a Dot1XConfigResponse with success/failure status and details.
+// Returns a Dot1XConfigResponse with success/failure status and details, or an
+// InvalidArgument error listing the offending fields as BadRequest details when the
+// inner method is not supported by the outer method.
 func (s *Dot1xService) ConfigureInterface(ctx context.Context, req *pb.Dot1XConfigRequest) (*pb.Dot1XConfigResponse, error) {
 	// Check for context cancellation before processing
 	select {
@@ -61,8 +71,44 @@ func (s *Dot1xService) ConfigureInterface(ctx context.Context, req *pb.Dot1XConf
 	// Measure and log operation duration
 	start := time.Now()
 	resp, err := s.manager.Configure(req)
+	if err != nil {
+		log.Printf("[WARN] Configure %s (%s) failed in %s: %v", req.Interface, req.EapType.String(), time.Since(start), err)
+		return nil, toStatusError(err)
+	}
 	log.Printf("[INFO] Configure %s (%s) in %s: %s", req.Interface, req.EapType.String(), time.Since(start), resp.Message)
-	return resp, err
+	return resp, nil
+}
+
+// ConfigureAndWatch configures 802.1X authentication for a network interface and
+// streams the authentication progress (network added, selected, EAPOL started,
+// method negotiated, authenticated, address acquired) until a terminal stage is
+// reached or the client-supplied timeout expires.
+//
+// The final message has Terminal set and reports the outcome in Success, which
+// lets provisioning scripts block until authentication actually completes.
+func (s *Dot1xService) ConfigureAndWatch(req *pb.ConfigureAndWatchRequest, stream pb.Dot1XManager_ConfigureAndWatchServer) error {
+	if req.Config == nil {
+		return status.Error(codes.InvalidArgument, "config is required")
+	}
+
+	start := time.Now()
+	var last *pb.ConfigureProgress
+	err := s.manager.ConfigureAndWatch(stream.Context(), req, func(p *pb.ConfigureProgress) error {
+		last = p
+		return stream.Send(p)
+	})
+	if err != nil {
+		log.Printf("[WARN] ConfigureAndWatch %s aborted after %s: %v", req.Config.Interface, time.Since(start), err)
+		if errors.Is(err, core.ErrInvalidArgument) {
+			return toStatusError(err)
+		}
+		return err
+	}
+	if last != nil {
+		log.Printf("[INFO] ConfigureAndWatch %s (%s) finished in %s: %s %s",
+			req.Config.Interface, req.Config.EapType.String(), time.Since(start), last.Stage, last.Message)
+	}
+	return nil
 }
 
 // Disconnect terminates the 802.1X authentication session for the specified interface.
@@ -83,56 +129,262 @@ func (s *Dot1xService) Disconnect(ctx context.Context, req *pb.InterfaceRequest)
 	return s.manager.Disconnect(req)
 }
 
+// Reauthenticate starts a new EAP authentication on a configured interface
+// without changing its configuration.
+//
+// Returns a ReauthenticateResponse indicating success or failure.
+func (s *Dot1xService) Reauthenticate(ctx context.Context, req *pb.InterfaceRequest) (*pb.ReauthenticateResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] Reauthenticate canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	log.Printf("[INFO] Reauthenticate %s", req.Interface)
+	return s.manager.Reauthenticate(req)
+}
+
+// EapolLogoff sends EAPOL-Logoff on a configured interface, which stays
+// unauthenticated until EapolLogon.
+//
+// Returns an EapolLogoffResponse indicating success or failure.
+func (s *Dot1xService) EapolLogoff(ctx context.Context, req *pb.InterfaceRequest) (*pb.EapolLogoffResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] EapolLogoff canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	log.Printf("[INFO] EapolLogoff %s", req.Interface)
+	return s.manager.EapolLogoff(req)
+}
+
+// EapolLogon lets an interface logged off with EapolLogoff authenticate again.
+//
+// Returns an EapolLogonResponse indicating success or failure.
+func (s *Dot1xService) EapolLogon(ctx context.Context, req *pb.InterfaceRequest) (*pb.EapolLogonResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] EapolLogon canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	log.Printf("[INFO] EapolLogon %s", req.Interface)
+	return s.manager.EapolLogon(req)
+}
+
 // GetStatus retrieves the current status of a network interface.
-// This is a mock implementation that returns static status information.
-// In a production environment, this would query the actual interface state
-// from wpa_supplicant via D-Bus.
+// The state is read from the wpa_supplicant interface object via the core manager.
 //
-// Returns an InterfaceStatus with current interface information.
+// Returns an InterfaceStatus with current interface information, or a NotFound
+// error if the interface is not managed by this service.
 func (s *Dot1xService) GetStatus(ctx context.Context, req *pb.InterfaceRequest) (*pb.InterfaceStatus, error) {
-	return &pb.InterfaceStatus{
-		Interface: req.Interface,
-		Status:    "mock-status",
-		EapState:  "mock-success",
-		LastEvent: "connected",
-		Timestamp: time.Now().Unix(),
-		IpAddress: "192.0.2.1",
-	}, nil
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] GetStatus canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	resp, err := s.manager.Status(req.Interface)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return resp, nil
 }
 
 // StreamStatus provides a real-time stream of interface status updates.
-// This method implements server-side streaming gRPC, sending status updates
-// every 3 seconds until the client disconnects or the context is canceled.
+// This method implements server-side streaming gRPC. The first message is a
+// snapshot of the current state; further messages are sent only when
+// wpa_supplicant signals a change of the interface state.
 //
 // The stream includes:
-//   - Interface name and current status
+//   - Interface name and current supplicant state
 //   - EAP authentication state
-//   - Last authentication event
+//   - Last event that triggered the update
 //   - Timestamp of the status update
-//   - IP address (when available)
 //
 // The stream continues until the client closes the connection or the context is canceled.
+// Returns a NotFound error if the interface is not managed by this service.
 func (s *Dot1xService) StreamStatus(req *pb.InterfaceRequest, stream pb.Dot1XManager_StreamStatusServer) error {
-	ticker := time.NewTicker(3 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-stream.Context().Done():
-			return nil
-		case t := <-ticker.C:
-			err := stream.Send(&pb.InterfaceStatus{
-				Interface: req.Interface,
-				Status:    "streaming",
-				EapState:  "authenticating",
-				LastEvent: "EAP-STARTED",
-				Timestamp: t.Unix(),
-				IpAddress: "",
-			})
-			if err != nil {
-				return err
-			}
+	updates, err := s.manager.WatchStatus(stream.Context(), req.Interface)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	log.Printf("[INFO] StreamStatus %s opened", req.Interface)
+	for st := range updates {
+		if err := stream.Send(st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamAllStatus provides a single stream of status updates for every managed
+// interface, including interfaces configured after the stream was opened. The
+// optional filter restricts the stream to the named interfaces.
+//
+// Each interface starts with a snapshot of its current state, followed by updates
+// when wpa_supplicant signals a change. The stream continues until the client
+// closes the connection or the context is canceled.
+func (s *Dot1xService) StreamAllStatus(req *pb.StatusFilter, stream pb.Dot1XManager_StreamAllStatusServer) error {
+	updates, err := s.manager.WatchAllStatus(stream.Context(), req.Interfaces)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	log.Printf("[INFO] StreamAllStatus opened (filter: %v)", req.Interfaces)
+	for st := range updates {
+		if err := stream.Send(st); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StreamEapEvents streams the EAP events wpa_supplicant reports for an interface.
+// Each event carries the EAP step (started, method negotiation, server certificate
+// verification, completion), its parameter and the time it was received, which
+// shows exactly where an authentication stalls.
+//
+// The stream continues until the client closes the connection or the context is canceled.
+// Returns a NotFound error if the interface is not managed by this service.
+func (s *Dot1xService) StreamEapEvents(req *pb.InterfaceRequest, stream pb.Dot1XManager_StreamEapEventsServer) error {
+	events, err := s.manager.WatchEAP(stream.Context(), req.Interface)
+	if err != nil {
+		return toStatusError(err)
+	}
+
+	log.Printf("[INFO] StreamEapEvents %s opened", req.Interface)
+	for ev := range events {
+		if err := stream.Send(ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListCertificates lists the certificates in the applied configuration of the
+// managed interfaces (optionally restricted to the requested ones), with the
+// interfaces using each and whether it is within the expiry warning window.
+func (s *Dot1xService) ListCertificates(ctx context.Context, req *pb.ListCertificatesRequest) (*pb.ListCertificatesResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] ListCertificates canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	return &pb.ListCertificatesResponse{Certificates: s.manager.Certificates(req.Interfaces...)}, nil
+}
+
+// ListPacs lists the EAP-FAST PACs provisioned for the requested interfaces, or for
+// every interface if none are requested. The secret parts of the PACs are never
+// returned.
+func (s *Dot1xService) ListPacs(ctx context.Context, req *pb.ListPacsRequest) (*pb.ListPacsResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] ListPacs canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	pacs, err := s.manager.Pacs(req.Interfaces...)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.ListPacsResponse{Pacs: pacs}, nil
+}
+
+// ClearPacs deletes the EAP-FAST PACs of the requested interfaces, so their next
+// authentication provisions new ones. At least one interface must be given.
+//
+// Returns the interfaces that had a PAC.
+func (s *Dot1xService) ClearPacs(ctx context.Context, req *pb.ClearPacsRequest) (*pb.ClearPacsResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] ClearPacs canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	if len(req.Interfaces) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one interface is required")
+	}
+	log.Printf("[INFO] ClearPacs %v", req.Interfaces)
+	cleared, err := s.manager.ClearPacs(req.Interfaces...)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.ClearPacsResponse{Cleared: cleared}, nil
+}
+
+// ListEapMethods reports the EAP methods of the API that the local wpa_supplicant
+// build supports, and every method wpa_supplicant itself reports. Configuring an
+// unsupported method is rejected.
+func (s *Dot1xService) ListEapMethods(ctx context.Context, req *pb.ListEapMethodsRequest) (*pb.ListEapMethodsResponse, error) {
+	// Check for context cancellation before processing
+	select {
+	case <-ctx.Done():
+		log.Println("[WARN] ListEapMethods canceled")
+		return nil, ctx.Err()
+	default:
+	}
+
+	supported, methods, err := s.manager.SupportedEapMethods()
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	return &pb.ListEapMethodsResponse{Supported: supported, SupplicantMethods: methods}, nil
+}
+
+// Certificates returns the tracked certificates, for exporting them as metrics.
+func (s *Dot1xService) Certificates() []*pb.CertificateInfo {
+	return s.manager.Certificates()
+}
+
+// toStatusError converts a core manager error into a gRPC status error. Validation
+// errors carry their fields as BadRequest field violations.
+func toStatusError(err error) error {
+	var invalid *core.ValidationError
+	if errors.As(err, &invalid) {
+		violations := make([]*errdetails.BadRequest_FieldViolation, len(invalid.Fields))
+		for i, fe := range invalid.Fields {
+			violations[i] = &errdetails.BadRequest_FieldViolation{Field: fe.Field, Description: fe.Reason}
 		}
+		st := status.New(codes.InvalidArgument, err.Error())
+		if detailed, derr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations}); derr == nil {
+			st = detailed
+		}
+		return st.Err()
+	}
+	if errors.Is(err, core.ErrInterfaceNotFound) {
+		return status.Error(codes.NotFound, err.Error())
+	}
+	if errors.Is(err, core.ErrSupplicantUnavailable) {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
+
+// Reconcile re-applies the persisted desired configuration of every interface.
+// It should be called once at startup, before the server starts serving requests.
+// Failures are logged; interfaces that cannot be restored are left unconfigured.
+func (s *Dot1xService) Reconcile() {
+	log.Println("[INFO] Reconciling wpa_supplicant with desired state...")
+	if err := s.manager.Reconcile(); err != nil {
+		log.Printf("[WARN] Reconcile incomplete: %v", err)
 	}
 }
 
@@ -0,0 +1,75 @@
+// Package metrics exposes the service's Prometheus metrics, including the expiry
+// of the certificates handed to wpa_supplicant.
+package metrics
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// CertificateSource provides the certificates to export.
+type CertificateSource interface {
+	Certificates() []*pb.CertificateInfo
+}
+
+// certificateLabels identify a certificate as used by one interface. Subject and
+// serial are not unique (e.g. a re-issued root in a trust bundle); the fingerprint is.
+var certificateLabels = []string{"interface", "role", "subject", "serial", "fingerprint_sha256"}
+
+// certificateCollector reads the certificates from its source on every scrape, so
+// the metrics always reflect the applied configuration.
+type certificateCollector struct {
+	source   CertificateSource
+	notAfter *prometheus.Desc
+	expiring *prometheus.Desc
+}
+
+func newCertificateCollector(source CertificateSource) *certificateCollector {
+	return &certificateCollector{
+		source: source,
+		notAfter: prometheus.NewDesc("dot1x_certificate_not_after_timestamp_seconds",
+			"Expiry time of a certificate used by an interface.", certificateLabels, nil),
+		expiring: prometheus.NewDesc("dot1x_certificate_expiring",
+			"1 if a certificate used by an interface is within the expiry warning window or expired.", certificateLabels, nil),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (c *certificateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.notAfter
+	ch <- c.expiring
+}
+
+// Collect implements prometheus.Collector.
+func (c *certificateCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, cert := range c.source.Certificates() {
+		role := strings.ToLower(strings.TrimPrefix(cert.Role.String(), "CERTIFICATE_ROLE_"))
+		expiring := 0.0
+		if cert.Expiring {
+			expiring = 1
+		}
+		for _, ifname := range cert.Interfaces {
+			labels := []string{ifname, role, cert.Subject, cert.Serial, cert.FingerprintSha256}
+			ch <- prometheus.MustNewConstMetric(c.notAfter, prometheus.GaugeValue, float64(cert.NotAfter), labels...)
+			ch <- prometheus.MustNewConstMetric(c.expiring, prometheus.GaugeValue, expiring, labels...)
+		}
+	}
+}
+
+// Handler returns an HTTP handler serving the service metrics together with the
+// standard Go runtime and process metrics.
+func Handler(certs CertificateSource) http.Handler {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		newCertificateCollector(certs),
+	)
+	return promhttp.HandlerFor(reg, promhttp.HandlerOpts{})
+}
@@ -0,0 +1,60 @@
+// Package netlink reads kernel link and address information for network interfaces.
+// It is used to report the layer 2 and layer 3 state of a port alongside its
+// 802.1X authentication state.
+package netlink
+
+import (
+	"errors"
+	"net/netip"
+)
+
+// ErrUnsupported is returned by providers that cannot read link information on
+// the current platform.
+var ErrUnsupported = errors.New("link information not supported on this platform")
+
+// ErrLinkNotFound is returned when the kernel has no link with the requested name.
+var ErrLinkNotFound = errors.New("link not found")
+
+// LinkInfo describes the kernel state of a network interface.
+type LinkInfo struct {
+	// Name is the interface name.
+	Name string
+	// MAC is the hardware address in colon-separated hex notation.
+	MAC string
+	// Carrier reports whether the link has carrier (cable plugged in, peer up).
+	Carrier bool
+	// OperState is the RFC 2863 operational state (e.g. "up", "down", "dormant").
+	OperState string
+	// IPv4 holds the IPv4 addresses assigned to the interface in CIDR notation.
+	IPv4 []string
+	// IPv6 holds the usable IPv6 addresses assigned to the interface in CIDR notation.
+	// Tentative and duplicate-address-detection failed addresses are omitted.
+	IPv6 []string
+	// MACsec is the name of the MACsec link stacked on the interface (e.g. "macsec0"),
+	// or empty if there is none.
+	MACsec string
+}
+
+// PrimaryAddress returns the address most likely to have been assigned by the
+// network: the first IPv4 address, or else the first non link-local IPv6 address.
+// The prefix length is stripped. Returns an empty string if there is none.
+func (l *LinkInfo) PrimaryAddress() string {
+	for _, addrs := range [][]string{l.IPv4, l.IPv6} {
+		for _, a := range addrs {
+			p, err := netip.ParsePrefix(a)
+			if err != nil || p.Addr().IsLinkLocalUnicast() {
+				continue
+			}
+			return p.Addr().String()
+		}
+	}
+	return ""
+}
+
+// Provider reads link information for a named interface.
+// Implementations must be safe for concurrent use.
+type Provider interface {
+	// LinkInfo returns the current kernel state of the interface.
+	// Returns ErrLinkNotFound if the interface does not exist.
+	LinkInfo(ifname string) (*LinkInfo, error)
+}
@@ -0,0 +1,224 @@
+//go:build linux
+
+// Package netlink reads kernel link and address information for network interfaces.
+// This file implements the Provider interface using rtnetlink dump requests.
+package netlink
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"syscall"
+	"unsafe"
+)
+
+// Attribute types missing from the syscall package
+const (
+	iflaCarrier  = 33 // IFLA_CARRIER
+	iflaInfoKind = 1  // IFLA_INFO_KIND, nested in IFLA_LINKINFO
+)
+
+// operStates maps IF_OPER_* values to their RFC 2863 names.
+var operStates = map[uint8]string{
+	0: "unknown",
+	1: "notpresent",
+	2: "down",
+	3: "lowerlayerdown",
+	4: "testing",
+	5: "dormant",
+	6: "up",
+}
+
+// RTNetlink reads link information from the kernel through rtnetlink.
+type RTNetlink struct{}
+
+// NewRTNetlink creates a Provider backed by the kernel's rtnetlink interface.
+func NewRTNetlink() *RTNetlink {
+	return &RTNetlink{}
+}
+
+// LinkInfo dumps the kernel link and address tables and returns the state of
+// the named interface.
+//
+// Returns ErrLinkNotFound if no link with the given name exists.
+func (r *RTNetlink) LinkInfo(ifname string) (*LinkInfo, error) {
+	info, index, err := readLink(ifname)
+	if err != nil {
+		return nil, err
+	}
+	if err := readAddrs(index, info); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
+// link is a link of an RTM_GETLINK dump.
+type link struct {
+	info   *LinkInfo
+	index  int32
+	parent int32  // IFLA_LINK: index of the lower link of a stacked link, 0 if none
+	kind   string // IFLA_INFO_KIND (e.g. "macsec", "vlan"), empty for physical links
+}
+
+// readLink finds the named link in an RTM_GETLINK dump and returns its
+// information together with its interface index.
+func readLink(ifname string) (*LinkInfo, int32, error) {
+	links, err := dumpLinks()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	for _, l := range links {
+		if l.info.Name != ifname {
+			continue
+		}
+		for _, upper := range links {
+			if upper.kind == "macsec" && upper.parent == l.index {
+				l.info.MACsec = upper.info.Name
+				break
+			}
+		}
+		return l.info, l.index, nil
+	}
+	return nil, 0, fmt.Errorf("%w: %s", ErrLinkNotFound, ifname)
+}
+
+// dumpLinks returns every link of an RTM_GETLINK dump.
+func dumpLinks() ([]link, error) {
+	msgs, err := dump(syscall.RTM_GETLINK)
+	if err != nil {
+		return nil, err
+	}
+
+	var links []link
+	for _, m := range msgs {
+		if m.Header.Type != syscall.RTM_NEWLINK || len(m.Data) < syscall.SizeofIfInfomsg {
+			continue
+		}
+		ifi := (*syscall.IfInfomsg)(unsafe.Pointer(&m.Data[0]))
+		attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+		if err != nil {
+			continue
+		}
+
+		l := link{info: &LinkInfo{}, index: ifi.Index}
+		info := l.info
+		for _, a := range attrs {
+			switch a.Attr.Type {
+			case syscall.IFLA_IFNAME:
+				info.Name = cString(a.Value)
+			case syscall.IFLA_ADDRESS:
+				info.MAC = net.HardwareAddr(a.Value).String()
+			case syscall.IFLA_OPERSTATE:
+				if len(a.Value) > 0 {
+					info.OperState = operStates[a.Value[0]]
+				}
+			case iflaCarrier:
+				info.Carrier = len(a.Value) > 0 && a.Value[0] != 0
+			case syscall.IFLA_LINK:
+				if len(a.Value) >= 4 {
+					l.parent = *(*int32)(unsafe.Pointer(&a.Value[0]))
+				}
+			case syscall.IFLA_LINKINFO:
+				l.kind = linkKind(a.Value)
+			}
+		}
+		links = append(links, l)
+	}
+	return links, nil
+}
+
+// linkKind returns the IFLA_INFO_KIND attribute nested in an IFLA_LINKINFO value.
+func linkKind(b []byte) string {
+	for len(b) >= syscall.SizeofRtAttr {
+		a := (*syscall.RtAttr)(unsafe.Pointer(&b[0]))
+		if int(a.Len) < syscall.SizeofRtAttr || int(a.Len) > len(b) {
+			return ""
+		}
+		if a.Type == iflaInfoKind {
+			return cString(b[syscall.SizeofRtAttr:a.Len])
+		}
+		next := (int(a.Len) + syscall.RTA_ALIGNTO - 1) &^ (syscall.RTA_ALIGNTO - 1)
+		if next > len(b) {
+			return ""
+		}
+		b = b[next:]
+	}
+	return ""
+}
+
+// readAddrs collects the addresses of the given interface index from an
+// RTM_GETADDR dump into info.
+func readAddrs(index int32, info *LinkInfo) error {
+	msgs, err := dump(syscall.RTM_GETADDR)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range msgs {
+		if m.Header.Type != syscall.RTM_NEWADDR || len(m.Data) < syscall.SizeofIfAddrmsg {
+			continue
+		}
+		ifa := (*syscall.IfAddrmsg)(unsafe.Pointer(&m.Data[0]))
+		if int32(ifa.Index) != index {
+			continue
+		}
+		if ifa.Flags&(syscall.IFA_F_TENTATIVE|syscall.IFA_F_DADFAILED) != 0 {
+			continue
+		}
+		attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+		if err != nil {
+			continue
+		}
+
+		// IFA_LOCAL is the local address on point-to-point links, where
+		// IFA_ADDRESS holds the peer; prefer it when present.
+		var raw []byte
+		for _, a := range attrs {
+			switch a.Attr.Type {
+			case syscall.IFA_LOCAL:
+				raw = a.Value
+			case syscall.IFA_ADDRESS:
+				if raw == nil {
+					raw = a.Value
+				}
+			}
+		}
+		addr, ok := netip.AddrFromSlice(raw)
+		if !ok {
+			continue
+		}
+		prefix := netip.PrefixFrom(addr.Unmap(), int(ifa.Prefixlen)).String()
+
+		switch ifa.Family {
+		case syscall.AF_INET:
+			info.IPv4 = append(info.IPv4, prefix)
+		case syscall.AF_INET6:
+			info.IPv6 = append(info.IPv6, prefix)
+		}
+	}
+	return nil
+}
+
+// dump issues an rtnetlink dump request and parses the reply messages.
+func dump(proto int) ([]syscall.NetlinkMessage, error) {
+	rib, err := syscall.NetlinkRIB(proto, syscall.AF_UNSPEC)
+	if err != nil {
+		return nil, fmt.Errorf("netlink dump failed: %v", err)
+	}
+	msgs, err := syscall.ParseNetlinkMessage(rib)
+	if err != nil {
+		return nil, fmt.Errorf("netlink parse failed: %v", err)
+	}
+	return msgs, nil
+}
+
+// cString converts a NUL-terminated attribute value to a string.
+func cString(b []byte) string {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,18 @@
+//go:build !linux
+
+// Package netlink reads kernel link and address information for network interfaces.
+// This file provides a stub Provider for platforms without rtnetlink.
+package netlink
+
+// RTNetlink is unavailable on this platform; LinkInfo always fails.
+type RTNetlink struct{}
+
+// NewRTNetlink creates a Provider that reports ErrUnsupported on this platform.
+func NewRTNetlink() *RTNetlink {
+	return &RTNetlink{}
+}
+
+// LinkInfo always returns ErrUnsupported on this platform.
+func (r *RTNetlink) LinkInfo(ifname string) (*LinkInfo, error) {
+	return nil, ErrUnsupported
+}
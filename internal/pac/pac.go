@@ -0,0 +1,262 @@
+// Package pac manages the EAP-FAST Protected Access Credentials (PACs) that
+// wpa_supplicant provisions for each interface. PACs are kept in one file per
+// interface in a directory that survives restarts, so a port does not have to be
+// provisioned again every time the service or the host restarts.
+package pac
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/fsutil"
+)
+
+// Permissions and names used inside the PAC directory
+const (
+	dirMode    = 0700
+	fileSuffix = ".pac"
+)
+
+// header is the first line of a PAC file in the text format wpa_supplicant writes.
+const header = "wpa_supplicant EAP-FAST PAC file - version 1"
+
+// Errors returned when reading PAC files or storing PACs
+var (
+	ErrInvalidName = fsutil.ErrInvalidName
+	ErrMalformed   = errors.New("malformed PAC file")
+)
+
+// Entry describes one PAC in a PAC file. The PAC key and opaque are secret and
+// are never exposed.
+type Entry struct {
+	Type    int    // PAC-Type: 1 tunnel, 2 machine authentication, 3 user authorization
+	AID     []byte // Authority identifier of the server that issued the PAC
+	AIDInfo string // Human readable description of the authority
+	IID     []byte // Identity the PAC was issued to
+}
+
+// File is the PAC file of an interface.
+type File struct {
+	Interface string
+	Modified  time.Time // Last time wpa_supplicant (or an import) wrote the file
+	Entries   []Entry
+}
+
+// Store is a directory of per-interface PAC files.
+type Store struct {
+	root      string
+	temporary bool // Root was created by OpenTempStore and is removed on Close
+}
+
+// OpenStore opens (creating if needed) the PAC directory at root and restricts it
+// to mode 0700, as PACs are credentials.
+//
+// Returns an error if the directory cannot be created or secured.
+func OpenStore(root string) (*Store, error) {
+	if err := os.MkdirAll(root, dirMode); err != nil {
+		return nil, fmt.Errorf("failed to create PAC directory: %v", err)
+	}
+	if err := os.Chmod(root, dirMode); err != nil {
+		return nil, fmt.Errorf("failed to secure PAC directory: %v", err)
+	}
+	return &Store{root: root}, nil
+}
+
+// OpenTempStore creates a PAC directory with a random name in the system temporary
+// directory. It is removed entirely on Close, so PACs do not survive a restart.
+func OpenTempStore() (*Store, error) {
+	root, err := os.MkdirTemp("", "dot1x-pac-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PAC directory: %v", err)
+	}
+	return &Store{root: root, temporary: true}, nil
+}
+
+// Path returns the PAC file of an interface, which wpa_supplicant reads and
+// writes as the pac_file of its network. The file need not exist.
+func (s *Store) Path(ifname string) (string, error) {
+	if err := fsutil.ValidateName(ifname); err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, ifname+fileSuffix), nil
+}
+
+// Exists reports whether a PAC file has been provisioned for an interface.
+func (s *Store) Exists(ifname string) bool {
+	path, err := s.Path(ifname)
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(path)
+	return err == nil
+}
+
+// Import replaces the PAC file of an interface with data, which must be a PAC
+// file in the text format written by wpa_supplicant.
+func (s *Store) Import(ifname string, data []byte) error {
+	path, err := s.Path(ifname)
+	if err != nil {
+		return err
+	}
+	if _, err := Parse(data); err != nil {
+		return err
+	}
+	return fsutil.WriteAtomic(path, data)
+}
+
+// Clear deletes the PAC file of an interface, so the next EAP-FAST authentication
+// provisions a new PAC. It reports whether there was a file to delete.
+func (s *Store) Clear(ifname string) (bool, error) {
+	path, err := s.Path(ifname)
+	if err != nil {
+		return false, err
+	}
+	err = os.Remove(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to remove PAC file of %s: %v", ifname, err)
+	}
+	return true, nil
+}
+
+// List reads the PAC files of the given interfaces, or of every interface if
+// none are given, ordered by interface name. Interfaces without a PAC file are
+// skipped.
+func (s *Store) List(ifnames ...string) ([]File, error) {
+	if len(ifnames) == 0 {
+		entries, err := os.ReadDir(s.root)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PAC directory: %v", err)
+		}
+		for _, e := range entries {
+			if name, ok := strings.CutSuffix(e.Name(), fileSuffix); ok && e.Type().IsRegular() {
+				ifnames = append(ifnames, name)
+			}
+		}
+	}
+	sort.Strings(ifnames)
+
+	var files []File
+	for _, ifname := range ifnames {
+		path, err := s.Path(ifname)
+		if err != nil {
+			return nil, err
+		}
+		data, err := os.ReadFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PAC file of %s: %v", ifname, err)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PAC file of %s: %v", ifname, err)
+		}
+		entries, err := Parse(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", ifname, err)
+		}
+		files = append(files, File{Interface: ifname, Modified: info.ModTime(), Entries: entries})
+	}
+	return files, nil
+}
+
+// Close deletes the PAC directory if it was created by OpenTempStore. PACs in a
+// directory opened with OpenStore are kept.
+func (s *Store) Close() error {
+	if s.temporary {
+		return os.RemoveAll(s.root)
+	}
+	return nil
+}
+
+// Parse parses a PAC file in the text format written by wpa_supplicant:
+//
+//	wpa_supplicant EAP-FAST PAC file - version 1
+//	START
+//	PAC-Type=1
+//	PAC-Key=...
+//	PAC-Opaque=...
+//	A-ID=...
+//	I-ID=...
+//	A-ID-Info=...
+//	END
+//
+// Binary identifiers are hex encoded. Fields other than the ones in Entry are
+// checked for structure only.
+func Parse(data []byte) ([]Entry, error) {
+	sc := bufio.NewScanner(bytes.NewReader(data))
+	if !sc.Scan() || strings.TrimSpace(sc.Text()) != header {
+		return nil, fmt.Errorf("%w: missing header", ErrMalformed)
+	}
+
+	var entries []Entry
+	var cur *Entry
+	for line := 2; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		switch {
+		case text == "" || strings.HasPrefix(text, "#"):
+		case text == "START":
+			if cur != nil {
+				return nil, fmt.Errorf("%w: line %d: START inside an entry", ErrMalformed, line)
+			}
+			cur = &Entry{Type: 1} // wpa_supplicant assumes a tunnel PAC when PAC-Type is absent
+		case text == "END":
+			if cur == nil {
+				return nil, fmt.Errorf("%w: line %d: END outside an entry", ErrMalformed, line)
+			}
+			entries = append(entries, *cur)
+			cur = nil
+		default:
+			if cur == nil {
+				return nil, fmt.Errorf("%w: line %d: field outside an entry", ErrMalformed, line)
+			}
+			field, value, ok := strings.Cut(text, "=")
+			if !ok {
+				return nil, fmt.Errorf("%w: line %d: expected field=value", ErrMalformed, line)
+			}
+			if err := cur.set(field, value); err != nil {
+				return nil, fmt.Errorf("%w: line %d: %s: %v", ErrMalformed, line, field, err)
+			}
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	if cur != nil {
+		return nil, fmt.Errorf("%w: unterminated entry", ErrMalformed)
+	}
+	return entries, nil
+}
+
+// set stores a field of a PAC file entry.
+func (e *Entry) set(field, value string) error {
+	var err error
+	switch field {
+	case "PAC-Type":
+		e.Type, err = strconv.Atoi(value)
+	case "A-ID":
+		e.AID, err = hex.DecodeString(value)
+	case "I-ID":
+		e.IID, err = hex.DecodeString(value)
+	case "A-ID-Info":
+		var info []byte
+		info, err = hex.DecodeString(value)
+		e.AIDInfo = string(info)
+	case "PAC-Key", "PAC-Opaque", "PAC-Info":
+		_, err = hex.DecodeString(value)
+	}
+	return err
+}
@@ -0,0 +1,223 @@
+// Package store persists the desired 802.1X configuration of each managed interface
+// so that it survives restarts of the service. Records are kept on disk with all
+// configuration data, including credentials, encrypted at rest with a key kept
+// outside the store directory.
+package store
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/fsutil"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// File names and permissions used inside the store directory
+const (
+	recordSuffix = ".state"
+	keySize      = 32 // AES-256
+	dirMode      = 0700
+)
+
+// ErrInvalidInterface is returned for interface names that cannot be stored safely.
+var ErrInvalidInterface = fsutil.ErrInvalidName
+
+// Store persists the desired configuration of managed interfaces.
+// Implementations must be safe for concurrent use.
+type Store interface {
+	// Save records the desired configuration of an interface, replacing any previous one.
+	Save(req *pb.Dot1XConfigRequest) error
+
+	// Delete removes the desired configuration of an interface, if any.
+	Delete(ifname string) error
+
+	// Load returns the desired configuration of every stored interface.
+	Load() ([]*pb.Dot1XConfigRequest, error)
+}
+
+// record is the on-disk representation of a desired configuration. Only the
+// metadata needed for auditing is kept in clear; the request itself is sealed.
+type record struct {
+	Interface string    `json:"interface"`
+	EapType   string    `json:"eap_type"`
+	UpdatedAt time.Time `json:"updated_at"`
+	Sealed    []byte    `json:"sealed"` // nonce || AES-GCM(proto-encoded Dot1xConfigRequest)
+}
+
+// FileStore is a Store that keeps one encrypted record per interface in a directory.
+// The AES-256-GCM key is read from a key file outside the directory, which is
+// generated on first use, so a copy of the records alone does not reveal them.
+type FileStore struct {
+	dir  string
+	aead cipher.AEAD
+	mu   sync.Mutex
+}
+
+// OpenFileStore opens (creating if needed) a store in dir, encrypted with the key
+// in keyPath. The directory is created with mode 0700 and a missing key with mode
+// 0600. The key must be outside dir, so a copy of the records does not include it.
+//
+// Returns an error if the directory or key cannot be created or read.
+func OpenFileStore(dir, keyPath string) (*FileStore, error) {
+	if rel, err := filepath.Rel(dir, keyPath); err == nil && !strings.HasPrefix(rel, "..") {
+		return nil, fmt.Errorf("state key %s must be outside the state directory %s", keyPath, dir)
+	}
+	if err := os.MkdirAll(dir, dirMode); err != nil {
+		return nil, fmt.Errorf("failed to create state directory: %v", err)
+	}
+	key, err := loadOrCreateKey(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid state key: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &FileStore{dir: dir, aead: aead}, nil
+}
+
+// Save encrypts the request and atomically replaces the record of its interface.
+func (s *FileStore) Save(req *pb.Dot1XConfigRequest) error {
+	if err := fsutil.ValidateName(req.Interface); err != nil {
+		return err
+	}
+	plain, err := proto.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("failed to encode configuration: %v", err)
+	}
+
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("failed to generate nonce: %v", err)
+	}
+	// Bind the ciphertext to its interface so records cannot be swapped
+	sealed := s.aead.Seal(nonce, nonce, plain, []byte(req.Interface))
+
+	data, err := json.MarshalIndent(record{
+		Interface: req.Interface,
+		EapType:   req.EapType.String(),
+		UpdatedAt: time.Now().UTC(),
+		Sealed:    sealed,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return fsutil.WriteAtomic(s.recordPath(req.Interface), data)
+}
+
+// Delete removes the record of an interface. A missing record is not an error.
+func (s *FileStore) Delete(ifname string) error {
+	if err := fsutil.ValidateName(ifname); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.Remove(s.recordPath(ifname)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete state of %s: %v", ifname, err)
+	}
+	return nil
+}
+
+// Load decrypts every record in the store. Records that cannot be read or
+// decrypted are skipped and reported in the returned error, so that one bad
+// record does not prevent the others from being restored.
+func (s *FileStore) Load() ([]*pb.Dot1XConfigRequest, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state directory: %v", err)
+	}
+
+	var reqs []*pb.Dot1XConfigRequest
+	var errs []error
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), recordSuffix) {
+			continue
+		}
+		req, err := s.readRecord(filepath.Join(s.dir, e.Name()))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", e.Name(), err))
+			continue
+		}
+		reqs = append(reqs, req)
+	}
+	return reqs, errors.Join(errs...)
+}
+
+// readRecord reads and decrypts a single record file.
+func (s *FileStore) readRecord(path string) (*pb.Dot1XConfigRequest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rec record
+	if err := json.Unmarshal(data, &rec); err != nil {
+		return nil, fmt.Errorf("malformed record: %v", err)
+	}
+
+	n := s.aead.NonceSize()
+	if len(rec.Sealed) < n {
+		return nil, errors.New("truncated record")
+	}
+	plain, err := s.aead.Open(nil, rec.Sealed[:n], rec.Sealed[n:], []byte(rec.Interface))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt record: %v", err)
+	}
+
+	req := &pb.Dot1XConfigRequest{}
+	if err := proto.Unmarshal(plain, req); err != nil {
+		return nil, fmt.Errorf("failed to decode configuration: %v", err)
+	}
+	return req, nil
+}
+
+// recordPath returns the file holding the record of an interface.
+func (s *FileStore) recordPath(ifname string) string {
+	return filepath.Join(s.dir, ifname+recordSuffix)
+}
+
+// loadOrCreateKey reads the store key, generating a new random key if none exists.
+func loadOrCreateKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err == nil {
+		if len(key) != keySize {
+			return nil, fmt.Errorf("state key %s has invalid length %d", path, len(key))
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read state key: %v", err)
+	}
+
+	key = make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate state key: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), dirMode); err != nil {
+		return nil, fmt.Errorf("failed to create state key directory: %v", err)
+	}
+	if err := fsutil.WriteAtomic(path, key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
@@ -21,6 +21,385 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type KeyManagement int32
+
+const (
+	KeyManagement_KEY_MGMT_UNSPECIFIED         KeyManagement = 0
+	KeyManagement_KEY_MGMT_WPA_EAP             KeyManagement = 1
+	KeyManagement_KEY_MGMT_WPA_EAP_SHA256      KeyManagement = 2
+	KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192 KeyManagement = 3
+)
+
+// Enum value maps for KeyManagement.
+var (
+	KeyManagement_name = map[int32]string{
+		0: "KEY_MGMT_UNSPECIFIED",
+		1: "KEY_MGMT_WPA_EAP",
+		2: "KEY_MGMT_WPA_EAP_SHA256",
+		3: "KEY_MGMT_WPA_EAP_SUITE_B_192",
+	}
+	KeyManagement_value = map[string]int32{
+		"KEY_MGMT_UNSPECIFIED":         0,
+		"KEY_MGMT_WPA_EAP":             1,
+		"KEY_MGMT_WPA_EAP_SHA256":      2,
+		"KEY_MGMT_WPA_EAP_SUITE_B_192": 3,
+	}
+)
+
+func (x KeyManagement) Enum() *KeyManagement {
+	p := new(KeyManagement)
+	*p = x
+	return p
+}
+
+func (x KeyManagement) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyManagement) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyManagement) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[0]
+}
+
+func (x KeyManagement) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyManagement.Descriptor instead.
+func (KeyManagement) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{0}
+}
+
+type PmfMode int32
+
+const (
+	PmfMode_PMF_DEFAULT  PmfMode = 0
+	PmfMode_PMF_DISABLED PmfMode = 1
+	PmfMode_PMF_OPTIONAL PmfMode = 2
+	PmfMode_PMF_REQUIRED PmfMode = 3
+)
+
+// Enum value maps for PmfMode.
+var (
+	PmfMode_name = map[int32]string{
+		0: "PMF_DEFAULT",
+		1: "PMF_DISABLED",
+		2: "PMF_OPTIONAL",
+		3: "PMF_REQUIRED",
+	}
+	PmfMode_value = map[string]int32{
+		"PMF_DEFAULT":  0,
+		"PMF_DISABLED": 1,
+		"PMF_OPTIONAL": 2,
+		"PMF_REQUIRED": 3,
+	}
+)
+
+func (x PmfMode) Enum() *PmfMode {
+	p := new(PmfMode)
+	*p = x
+	return p
+}
+
+func (x PmfMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PmfMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[1].Descriptor()
+}
+
+func (PmfMode) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[1]
+}
+
+func (x PmfMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PmfMode.Descriptor instead.
+func (PmfMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{1}
+}
+
+type Phase2Method int32
+
+const (
+	Phase2Method_PHASE2_UNSPECIFIED  Phase2Method = 0
+	Phase2Method_PHASE2_MSCHAPV2     Phase2Method = 1
+	Phase2Method_PHASE2_GTC          Phase2Method = 2
+	Phase2Method_PHASE2_MD5          Phase2Method = 3
+	Phase2Method_PHASE2_PAP          Phase2Method = 4
+	Phase2Method_PHASE2_CHAP         Phase2Method = 5
+	Phase2Method_PHASE2_MSCHAP       Phase2Method = 6
+	Phase2Method_PHASE2_EAP_MSCHAPV2 Phase2Method = 7
+	Phase2Method_PHASE2_EAP_GTC      Phase2Method = 8
+	Phase2Method_PHASE2_EAP_MD5      Phase2Method = 9
+)
+
+// Enum value maps for Phase2Method.
+var (
+	Phase2Method_name = map[int32]string{
+		0: "PHASE2_UNSPECIFIED",
+		1: "PHASE2_MSCHAPV2",
+		2: "PHASE2_GTC",
+		3: "PHASE2_MD5",
+		4: "PHASE2_PAP",
+		5: "PHASE2_CHAP",
+		6: "PHASE2_MSCHAP",
+		7: "PHASE2_EAP_MSCHAPV2",
+		8: "PHASE2_EAP_GTC",
+		9: "PHASE2_EAP_MD5",
+	}
+	Phase2Method_value = map[string]int32{
+		"PHASE2_UNSPECIFIED":  0,
+		"PHASE2_MSCHAPV2":     1,
+		"PHASE2_GTC":          2,
+		"PHASE2_MD5":          3,
+		"PHASE2_PAP":          4,
+		"PHASE2_CHAP":         5,
+		"PHASE2_MSCHAP":       6,
+		"PHASE2_EAP_MSCHAPV2": 7,
+		"PHASE2_EAP_GTC":      8,
+		"PHASE2_EAP_MD5":      9,
+	}
+)
+
+func (x Phase2Method) Enum() *Phase2Method {
+	p := new(Phase2Method)
+	*p = x
+	return p
+}
+
+func (x Phase2Method) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Phase2Method) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[2].Descriptor()
+}
+
+func (Phase2Method) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[2]
+}
+
+func (x Phase2Method) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Phase2Method.Descriptor instead.
+func (Phase2Method) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{2}
+}
+
+type MacsecMode int32
+
+const (
+	MacsecMode_MACSEC_MODE_DISABLED MacsecMode = 0
+	MacsecMode_MACSEC_MODE_EAP      MacsecMode = 1
+	MacsecMode_MACSEC_MODE_PSK      MacsecMode = 2
+)
+
+// Enum value maps for MacsecMode.
+var (
+	MacsecMode_name = map[int32]string{
+		0: "MACSEC_MODE_DISABLED",
+		1: "MACSEC_MODE_EAP",
+		2: "MACSEC_MODE_PSK",
+	}
+	MacsecMode_value = map[string]int32{
+		"MACSEC_MODE_DISABLED": 0,
+		"MACSEC_MODE_EAP":      1,
+		"MACSEC_MODE_PSK":      2,
+	}
+)
+
+func (x MacsecMode) Enum() *MacsecMode {
+	p := new(MacsecMode)
+	*p = x
+	return p
+}
+
+func (x MacsecMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MacsecMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[3].Descriptor()
+}
+
+func (MacsecMode) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[3]
+}
+
+func (x MacsecMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MacsecMode.Descriptor instead.
+func (MacsecMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{3}
+}
+
+type PeapVersion int32
+
+const (
+	PeapVersion_PEAP_VERSION_AUTO PeapVersion = 0
+	PeapVersion_PEAP_VERSION_0    PeapVersion = 1
+	PeapVersion_PEAP_VERSION_1    PeapVersion = 2
+)
+
+// Enum value maps for PeapVersion.
+var (
+	PeapVersion_name = map[int32]string{
+		0: "PEAP_VERSION_AUTO",
+		1: "PEAP_VERSION_0",
+		2: "PEAP_VERSION_1",
+	}
+	PeapVersion_value = map[string]int32{
+		"PEAP_VERSION_AUTO": 0,
+		"PEAP_VERSION_0":    1,
+		"PEAP_VERSION_1":    2,
+	}
+)
+
+func (x PeapVersion) Enum() *PeapVersion {
+	p := new(PeapVersion)
+	*p = x
+	return p
+}
+
+func (x PeapVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeapVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[4].Descriptor()
+}
+
+func (PeapVersion) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[4]
+}
+
+func (x PeapVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeapVersion.Descriptor instead.
+func (PeapVersion) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{4}
+}
+
+type TlsVersion int32
+
+const (
+	TlsVersion_TLS_VERSION_UNSPECIFIED TlsVersion = 0
+	TlsVersion_TLS_VERSION_1_0         TlsVersion = 1
+	TlsVersion_TLS_VERSION_1_1         TlsVersion = 2
+	TlsVersion_TLS_VERSION_1_2         TlsVersion = 3
+	TlsVersion_TLS_VERSION_1_3         TlsVersion = 4
+)
+
+// Enum value maps for TlsVersion.
+var (
+	TlsVersion_name = map[int32]string{
+		0: "TLS_VERSION_UNSPECIFIED",
+		1: "TLS_VERSION_1_0",
+		2: "TLS_VERSION_1_1",
+		3: "TLS_VERSION_1_2",
+		4: "TLS_VERSION_1_3",
+	}
+	TlsVersion_value = map[string]int32{
+		"TLS_VERSION_UNSPECIFIED": 0,
+		"TLS_VERSION_1_0":         1,
+		"TLS_VERSION_1_1":         2,
+		"TLS_VERSION_1_2":         3,
+		"TLS_VERSION_1_3":         4,
+	}
+)
+
+func (x TlsVersion) Enum() *TlsVersion {
+	p := new(TlsVersion)
+	*p = x
+	return p
+}
+
+func (x TlsVersion) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TlsVersion) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[5].Descriptor()
+}
+
+func (TlsVersion) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[5]
+}
+
+func (x TlsVersion) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TlsVersion.Descriptor instead.
+func (TlsVersion) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{5}
+}
+
+type FastProvisioning int32
+
+const (
+	FastProvisioning_FAST_PROVISIONING_DISABLED        FastProvisioning = 0
+	FastProvisioning_FAST_PROVISIONING_UNAUTHENTICATED FastProvisioning = 1
+	FastProvisioning_FAST_PROVISIONING_AUTHENTICATED   FastProvisioning = 2
+	FastProvisioning_FAST_PROVISIONING_BOTH            FastProvisioning = 3
+)
+
+// Enum value maps for FastProvisioning.
+var (
+	FastProvisioning_name = map[int32]string{
+		0: "FAST_PROVISIONING_DISABLED",
+		1: "FAST_PROVISIONING_UNAUTHENTICATED",
+		2: "FAST_PROVISIONING_AUTHENTICATED",
+		3: "FAST_PROVISIONING_BOTH",
+	}
+	FastProvisioning_value = map[string]int32{
+		"FAST_PROVISIONING_DISABLED":        0,
+		"FAST_PROVISIONING_UNAUTHENTICATED": 1,
+		"FAST_PROVISIONING_AUTHENTICATED":   2,
+		"FAST_PROVISIONING_BOTH":            3,
+	}
+)
+
+func (x FastProvisioning) Enum() *FastProvisioning {
+	p := new(FastProvisioning)
+	*p = x
+	return p
+}
+
+func (x FastProvisioning) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FastProvisioning) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[6].Descriptor()
+}
+
+func (FastProvisioning) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[6]
+}
+
+func (x FastProvisioning) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FastProvisioning.Descriptor instead.
+func (FastProvisioning) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{6}
+}
+
 type EapType int32
 
 const (
@@ -29,6 +408,10 @@ const (
 	EapType_EAP_PEAP    EapType = 2
 	EapType_EAP_TTLS    EapType = 3
 	EapType_EAP_FAST    EapType = 4
+	EapType_EAP_TEAP    EapType = 5
+	EapType_EAP_PWD     EapType = 6
+	EapType_EAP_MD5     EapType = 7
+	EapType_EAP_GTC     EapType = 8
 )
 
 // Enum value maps for EapType.
@@ -39,6 +422,10 @@ var (
 		2: "EAP_PEAP",
 		3: "EAP_TTLS",
 		4: "EAP_FAST",
+		5: "EAP_TEAP",
+		6: "EAP_PWD",
+		7: "EAP_MD5",
+		8: "EAP_GTC",
 	}
 	EapType_value = map[string]int32{
 		"EAP_UNKNOWN": 0,
@@ -46,6 +433,10 @@ var (
 		"EAP_PEAP":    2,
 		"EAP_TTLS":    3,
 		"EAP_FAST":    4,
+		"EAP_TEAP":    5,
+		"EAP_PWD":     6,
+		"EAP_MD5":     7,
+		"EAP_GTC":     8,
 	}
 )
 
@@ -60,52 +451,2071 @@ func (x EapType) String() string {
 }
 
 func (EapType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_ether8021x_proto_enumTypes[0].Descriptor()
+	return file_proto_ether8021x_proto_enumTypes[7].Descriptor()
 }
 
 func (EapType) Type() protoreflect.EnumType {
-	return &file_proto_ether8021x_proto_enumTypes[0]
+	return &file_proto_ether8021x_proto_enumTypes[7]
+}
+
+func (x EapType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EapType.Descriptor instead.
+func (EapType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{7}
+}
+
+type ConfigureStage int32
+
+const (
+	ConfigureStage_CONFIGURE_STAGE_UNKNOWN           ConfigureStage = 0
+	ConfigureStage_CONFIGURE_STAGE_NETWORK_ADDED     ConfigureStage = 1
+	ConfigureStage_CONFIGURE_STAGE_NETWORK_SELECTED  ConfigureStage = 2
+	ConfigureStage_CONFIGURE_STAGE_EAPOL_STARTED     ConfigureStage = 3
+	ConfigureStage_CONFIGURE_STAGE_METHOD_NEGOTIATED ConfigureStage = 4
+	ConfigureStage_CONFIGURE_STAGE_AUTHENTICATED     ConfigureStage = 5
+	ConfigureStage_CONFIGURE_STAGE_IP_ACQUIRED       ConfigureStage = 6
+	ConfigureStage_CONFIGURE_STAGE_FAILED            ConfigureStage = 7
+	ConfigureStage_CONFIGURE_STAGE_TIMED_OUT         ConfigureStage = 8
+)
+
+// Enum value maps for ConfigureStage.
+var (
+	ConfigureStage_name = map[int32]string{
+		0: "CONFIGURE_STAGE_UNKNOWN",
+		1: "CONFIGURE_STAGE_NETWORK_ADDED",
+		2: "CONFIGURE_STAGE_NETWORK_SELECTED",
+		3: "CONFIGURE_STAGE_EAPOL_STARTED",
+		4: "CONFIGURE_STAGE_METHOD_NEGOTIATED",
+		5: "CONFIGURE_STAGE_AUTHENTICATED",
+		6: "CONFIGURE_STAGE_IP_ACQUIRED",
+		7: "CONFIGURE_STAGE_FAILED",
+		8: "CONFIGURE_STAGE_TIMED_OUT",
+	}
+	ConfigureStage_value = map[string]int32{
+		"CONFIGURE_STAGE_UNKNOWN":           0,
+		"CONFIGURE_STAGE_NETWORK_ADDED":     1,
+		"CONFIGURE_STAGE_NETWORK_SELECTED":  2,
+		"CONFIGURE_STAGE_EAPOL_STARTED":     3,
+		"CONFIGURE_STAGE_METHOD_NEGOTIATED": 4,
+		"CONFIGURE_STAGE_AUTHENTICATED":     5,
+		"CONFIGURE_STAGE_IP_ACQUIRED":       6,
+		"CONFIGURE_STAGE_FAILED":            7,
+		"CONFIGURE_STAGE_TIMED_OUT":         8,
+	}
+)
+
+func (x ConfigureStage) Enum() *ConfigureStage {
+	p := new(ConfigureStage)
+	*p = x
+	return p
+}
+
+func (x ConfigureStage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigureStage) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[8].Descriptor()
+}
+
+func (ConfigureStage) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[8]
+}
+
+func (x ConfigureStage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigureStage.Descriptor instead.
+func (ConfigureStage) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{8}
+}
+
+type SupplicantState int32
+
+const (
+	SupplicantState_SUPPLICANT_STATE_UNKNOWN            SupplicantState = 0
+	SupplicantState_SUPPLICANT_STATE_DISCONNECTED       SupplicantState = 1
+	SupplicantState_SUPPLICANT_STATE_INTERFACE_DISABLED SupplicantState = 2
+	SupplicantState_SUPPLICANT_STATE_INACTIVE           SupplicantState = 3
+	SupplicantState_SUPPLICANT_STATE_SCANNING           SupplicantState = 4
+	SupplicantState_SUPPLICANT_STATE_AUTHENTICATING     SupplicantState = 5
+	SupplicantState_SUPPLICANT_STATE_ASSOCIATING        SupplicantState = 6
+	SupplicantState_SUPPLICANT_STATE_ASSOCIATED         SupplicantState = 7
+	SupplicantState_SUPPLICANT_STATE_4WAY_HANDSHAKE     SupplicantState = 8
+	SupplicantState_SUPPLICANT_STATE_GROUP_HANDSHAKE    SupplicantState = 9
+	SupplicantState_SUPPLICANT_STATE_COMPLETED          SupplicantState = 10
+)
+
+// Enum value maps for SupplicantState.
+var (
+	SupplicantState_name = map[int32]string{
+		0:  "SUPPLICANT_STATE_UNKNOWN",
+		1:  "SUPPLICANT_STATE_DISCONNECTED",
+		2:  "SUPPLICANT_STATE_INTERFACE_DISABLED",
+		3:  "SUPPLICANT_STATE_INACTIVE",
+		4:  "SUPPLICANT_STATE_SCANNING",
+		5:  "SUPPLICANT_STATE_AUTHENTICATING",
+		6:  "SUPPLICANT_STATE_ASSOCIATING",
+		7:  "SUPPLICANT_STATE_ASSOCIATED",
+		8:  "SUPPLICANT_STATE_4WAY_HANDSHAKE",
+		9:  "SUPPLICANT_STATE_GROUP_HANDSHAKE",
+		10: "SUPPLICANT_STATE_COMPLETED",
+	}
+	SupplicantState_value = map[string]int32{
+		"SUPPLICANT_STATE_UNKNOWN":            0,
+		"SUPPLICANT_STATE_DISCONNECTED":       1,
+		"SUPPLICANT_STATE_INTERFACE_DISABLED": 2,
+		"SUPPLICANT_STATE_INACTIVE":           3,
+		"SUPPLICANT_STATE_SCANNING":           4,
+		"SUPPLICANT_STATE_AUTHENTICATING":     5,
+		"SUPPLICANT_STATE_ASSOCIATING":        6,
+		"SUPPLICANT_STATE_ASSOCIATED":         7,
+		"SUPPLICANT_STATE_4WAY_HANDSHAKE":     8,
+		"SUPPLICANT_STATE_GROUP_HANDSHAKE":    9,
+		"SUPPLICANT_STATE_COMPLETED":          10,
+	}
+)
+
+func (x SupplicantState) Enum() *SupplicantState {
+	p := new(SupplicantState)
+	*p = x
+	return p
+}
+
+func (x SupplicantState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SupplicantState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[9].Descriptor()
+}
+
+func (SupplicantState) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[9]
+}
+
+func (x SupplicantState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SupplicantState.Descriptor instead.
+func (SupplicantState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{9}
+}
+
+type EapState int32
+
+const (
+	EapState_EAP_STATE_UNKNOWN     EapState = 0
+	EapState_EAP_STATE_IDLE        EapState = 1
+	EapState_EAP_STATE_IN_PROGRESS EapState = 2
+	EapState_EAP_STATE_SUCCESS     EapState = 3
+	EapState_EAP_STATE_FAILURE     EapState = 4
+)
+
+// Enum value maps for EapState.
+var (
+	EapState_name = map[int32]string{
+		0: "EAP_STATE_UNKNOWN",
+		1: "EAP_STATE_IDLE",
+		2: "EAP_STATE_IN_PROGRESS",
+		3: "EAP_STATE_SUCCESS",
+		4: "EAP_STATE_FAILURE",
+	}
+	EapState_value = map[string]int32{
+		"EAP_STATE_UNKNOWN":     0,
+		"EAP_STATE_IDLE":        1,
+		"EAP_STATE_IN_PROGRESS": 2,
+		"EAP_STATE_SUCCESS":     3,
+		"EAP_STATE_FAILURE":     4,
+	}
+)
+
+func (x EapState) Enum() *EapState {
+	p := new(EapState)
+	*p = x
+	return p
+}
+
+func (x EapState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EapState) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[10].Descriptor()
+}
+
+func (EapState) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[10]
+}
+
+func (x EapState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EapState.Descriptor instead.
+func (EapState) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{10}
+}
+
+type EapEventType int32
+
+const (
+	EapEventType_EAP_EVENT_UNKNOWN                  EapEventType = 0
+	EapEventType_EAP_EVENT_STARTED                  EapEventType = 1
+	EapEventType_EAP_EVENT_PROPOSED_METHOD_ACCEPTED EapEventType = 2
+	EapEventType_EAP_EVENT_PROPOSED_METHOD_REJECTED EapEventType = 3
+	EapEventType_EAP_EVENT_METHOD_SELECTED          EapEventType = 4
+	EapEventType_EAP_EVENT_PARAMETER_NEEDED         EapEventType = 5
+	EapEventType_EAP_EVENT_REMOTE_CERT_VERIFICATION EapEventType = 6
+	EapEventType_EAP_EVENT_REMOTE_TLS_ALERT         EapEventType = 7
+	EapEventType_EAP_EVENT_LOCAL_TLS_ALERT          EapEventType = 8
+	EapEventType_EAP_EVENT_COMPLETION               EapEventType = 9
+)
+
+// Enum value maps for EapEventType.
+var (
+	EapEventType_name = map[int32]string{
+		0: "EAP_EVENT_UNKNOWN",
+		1: "EAP_EVENT_STARTED",
+		2: "EAP_EVENT_PROPOSED_METHOD_ACCEPTED",
+		3: "EAP_EVENT_PROPOSED_METHOD_REJECTED",
+		4: "EAP_EVENT_METHOD_SELECTED",
+		5: "EAP_EVENT_PARAMETER_NEEDED",
+		6: "EAP_EVENT_REMOTE_CERT_VERIFICATION",
+		7: "EAP_EVENT_REMOTE_TLS_ALERT",
+		8: "EAP_EVENT_LOCAL_TLS_ALERT",
+		9: "EAP_EVENT_COMPLETION",
+	}
+	EapEventType_value = map[string]int32{
+		"EAP_EVENT_UNKNOWN":                  0,
+		"EAP_EVENT_STARTED":                  1,
+		"EAP_EVENT_PROPOSED_METHOD_ACCEPTED": 2,
+		"EAP_EVENT_PROPOSED_METHOD_REJECTED": 3,
+		"EAP_EVENT_METHOD_SELECTED":          4,
+		"EAP_EVENT_PARAMETER_NEEDED":         5,
+		"EAP_EVENT_REMOTE_CERT_VERIFICATION": 6,
+		"EAP_EVENT_REMOTE_TLS_ALERT":         7,
+		"EAP_EVENT_LOCAL_TLS_ALERT":          8,
+		"EAP_EVENT_COMPLETION":               9,
+	}
+)
+
+func (x EapEventType) Enum() *EapEventType {
+	p := new(EapEventType)
+	*p = x
+	return p
+}
+
+func (x EapEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EapEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[11].Descriptor()
+}
+
+func (EapEventType) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[11]
+}
+
+func (x EapEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EapEventType.Descriptor instead.
+func (EapEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{11}
+}
+
+type CertificateRole int32
+
+const (
+	CertificateRole_CERTIFICATE_ROLE_UNKNOWN CertificateRole = 0
+	CertificateRole_CERTIFICATE_ROLE_CLIENT  CertificateRole = 1
+	CertificateRole_CERTIFICATE_ROLE_CA      CertificateRole = 2
+)
+
+// Enum value maps for CertificateRole.
+var (
+	CertificateRole_name = map[int32]string{
+		0: "CERTIFICATE_ROLE_UNKNOWN",
+		1: "CERTIFICATE_ROLE_CLIENT",
+		2: "CERTIFICATE_ROLE_CA",
+	}
+	CertificateRole_value = map[string]int32{
+		"CERTIFICATE_ROLE_UNKNOWN": 0,
+		"CERTIFICATE_ROLE_CLIENT":  1,
+		"CERTIFICATE_ROLE_CA":      2,
+	}
+)
+
+func (x CertificateRole) Enum() *CertificateRole {
+	p := new(CertificateRole)
+	*p = x
+	return p
+}
+
+func (x CertificateRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CertificateRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_ether8021x_proto_enumTypes[12].Descriptor()
+}
+
+func (CertificateRole) Type() protoreflect.EnumType {
+	return &file_proto_ether8021x_proto_enumTypes[12]
+}
+
+func (x CertificateRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CertificateRole.Descriptor instead.
+func (CertificateRole) EnumDescriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{12}
+}
+
+type Dot1XConfigRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Interface          string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	EapType            EapType                `protobuf:"varint,2,opt,name=eap_type,json=eapType,proto3,enum=ether8021x.EapType" json:"eap_type,omitempty"`
+	Identity           string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	Password           string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	Phase2Auth         string                 `protobuf:"bytes,5,opt,name=phase2_auth,json=phase2Auth,proto3" json:"phase2_auth,omitempty"`
+	CaCert             []byte                 `protobuf:"bytes,6,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
+	ClientCert         []byte                 `protobuf:"bytes,7,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
+	PrivateKey         []byte                 `protobuf:"bytes,8,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	PrivateKeyPassword string                 `protobuf:"bytes,9,opt,name=private_key_password,json=privateKeyPassword,proto3" json:"private_key_password,omitempty"`
+	Pkcs12             []byte                 `protobuf:"bytes,10,opt,name=pkcs12,proto3" json:"pkcs12,omitempty"`
+	Pkcs12Password     string                 `protobuf:"bytes,11,opt,name=pkcs12_password,json=pkcs12Password,proto3" json:"pkcs12_password,omitempty"`
+	FastProvisioning   FastProvisioning       `protobuf:"varint,12,opt,name=fast_provisioning,json=fastProvisioning,proto3,enum=ether8021x.FastProvisioning" json:"fast_provisioning,omitempty"`
+	Pac                []byte                 `protobuf:"bytes,13,opt,name=pac,proto3" json:"pac,omitempty"`
+	DomainSuffixMatch  string                 `protobuf:"bytes,14,opt,name=domain_suffix_match,json=domainSuffixMatch,proto3" json:"domain_suffix_match,omitempty"`
+	DomainMatch        string                 `protobuf:"bytes,15,opt,name=domain_match,json=domainMatch,proto3" json:"domain_match,omitempty"`
+	AltsubjectMatch    []string               `protobuf:"bytes,16,rep,name=altsubject_match,json=altsubjectMatch,proto3" json:"altsubject_match,omitempty"`
+	CaPath             string                 `protobuf:"bytes,17,opt,name=ca_path,json=caPath,proto3" json:"ca_path,omitempty"`
+	// CA certificates of an inner TLS method; rejected, as no inner method uses TLS
+	CaCert2           []byte                `protobuf:"bytes,18,opt,name=ca_cert2,json=caCert2,proto3" json:"ca_cert2,omitempty"`
+	Phase1            *Phase1Options        `protobuf:"bytes,19,opt,name=phase1,proto3" json:"phase1,omitempty"`
+	AnonymousIdentity string                `protobuf:"bytes,20,opt,name=anonymous_identity,json=anonymousIdentity,proto3" json:"anonymous_identity,omitempty"`
+	Phase2            Phase2Method          `protobuf:"varint,21,opt,name=phase2,proto3,enum=ether8021x.Phase2Method" json:"phase2,omitempty"`
+	Macsec            *MacsecConfig         `protobuf:"bytes,22,opt,name=macsec,proto3" json:"macsec,omitempty"`
+	InterfaceOptions  *InterfaceOptions     `protobuf:"bytes,23,opt,name=interface_options,json=interfaceOptions,proto3" json:"interface_options,omitempty"`
+	Wireless          *WirelessConfig       `protobuf:"bytes,24,opt,name=wireless,proto3" json:"wireless,omitempty"`
+	Fallbacks         []*Dot1XConfigRequest `protobuf:"bytes,25,rep,name=fallbacks,proto3" json:"fallbacks,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Dot1XConfigRequest) Reset() {
+	*x = Dot1XConfigRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dot1XConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dot1XConfigRequest) ProtoMessage() {}
+
+func (x *Dot1XConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dot1XConfigRequest.ProtoReflect.Descriptor instead.
+func (*Dot1XConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Dot1XConfigRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetEapType() EapType {
+	if x != nil {
+		return x.EapType
+	}
+	return EapType_EAP_UNKNOWN
+}
+
+func (x *Dot1XConfigRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetPhase2Auth() string {
+	if x != nil {
+		return x.Phase2Auth
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetCaCert() []byte {
+	if x != nil {
+		return x.CaCert
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetClientCert() []byte {
+	if x != nil {
+		return x.ClientCert
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetPrivateKeyPassword() string {
+	if x != nil {
+		return x.PrivateKeyPassword
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetPkcs12() []byte {
+	if x != nil {
+		return x.Pkcs12
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetPkcs12Password() string {
+	if x != nil {
+		return x.Pkcs12Password
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetFastProvisioning() FastProvisioning {
+	if x != nil {
+		return x.FastProvisioning
+	}
+	return FastProvisioning_FAST_PROVISIONING_DISABLED
+}
+
+func (x *Dot1XConfigRequest) GetPac() []byte {
+	if x != nil {
+		return x.Pac
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetDomainSuffixMatch() string {
+	if x != nil {
+		return x.DomainSuffixMatch
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetDomainMatch() string {
+	if x != nil {
+		return x.DomainMatch
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetAltsubjectMatch() []string {
+	if x != nil {
+		return x.AltsubjectMatch
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetCaPath() string {
+	if x != nil {
+		return x.CaPath
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetCaCert2() []byte {
+	if x != nil {
+		return x.CaCert2
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetPhase1() *Phase1Options {
+	if x != nil {
+		return x.Phase1
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetAnonymousIdentity() string {
+	if x != nil {
+		return x.AnonymousIdentity
+	}
+	return ""
+}
+
+func (x *Dot1XConfigRequest) GetPhase2() Phase2Method {
+	if x != nil {
+		return x.Phase2
+	}
+	return Phase2Method_PHASE2_UNSPECIFIED
+}
+
+func (x *Dot1XConfigRequest) GetMacsec() *MacsecConfig {
+	if x != nil {
+		return x.Macsec
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetInterfaceOptions() *InterfaceOptions {
+	if x != nil {
+		return x.InterfaceOptions
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetWireless() *WirelessConfig {
+	if x != nil {
+		return x.Wireless
+	}
+	return nil
+}
+
+func (x *Dot1XConfigRequest) GetFallbacks() []*Dot1XConfigRequest {
+	if x != nil {
+		return x.Fallbacks
+	}
+	return nil
+}
+
+type WirelessConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ssid          string                 `protobuf:"bytes,1,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	KeyMgmt       []KeyManagement        `protobuf:"varint,2,rep,packed,name=key_mgmt,json=keyMgmt,proto3,enum=ether8021x.KeyManagement" json:"key_mgmt,omitempty"`
+	Pmf           PmfMode                `protobuf:"varint,3,opt,name=pmf,proto3,enum=ether8021x.PmfMode" json:"pmf,omitempty"`
+	Frequencies   []uint32               `protobuf:"varint,4,rep,packed,name=frequencies,proto3" json:"frequencies,omitempty"`
+	ScanSsid      bool                   `protobuf:"varint,5,opt,name=scan_ssid,json=scanSsid,proto3" json:"scan_ssid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WirelessConfig) Reset() {
+	*x = WirelessConfig{}
+	mi := &file_proto_ether8021x_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WirelessConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WirelessConfig) ProtoMessage() {}
+
+func (x *WirelessConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WirelessConfig.ProtoReflect.Descriptor instead.
+func (*WirelessConfig) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WirelessConfig) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *WirelessConfig) GetKeyMgmt() []KeyManagement {
+	if x != nil {
+		return x.KeyMgmt
+	}
+	return nil
+}
+
+func (x *WirelessConfig) GetPmf() PmfMode {
+	if x != nil {
+		return x.Pmf
+	}
+	return PmfMode_PMF_DEFAULT
+}
+
+func (x *WirelessConfig) GetFrequencies() []uint32 {
+	if x != nil {
+		return x.Frequencies
+	}
+	return nil
+}
+
+func (x *WirelessConfig) GetScanSsid() bool {
+	if x != nil {
+		return x.ScanSsid
+	}
+	return false
+}
+
+type InterfaceOptions struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Driver          string                 `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	BridgeInterface string                 `protobuf:"bytes,2,opt,name=bridge_interface,json=bridgeInterface,proto3" json:"bridge_interface,omitempty"`
+	ConfigFile      string                 `protobuf:"bytes,3,opt,name=config_file,json=configFile,proto3" json:"config_file,omitempty"`
+	EapolVersion    uint32                 `protobuf:"varint,4,opt,name=eapol_version,json=eapolVersion,proto3" json:"eapol_version,omitempty"`
+	FastReauth      *bool                  `protobuf:"varint,5,opt,name=fast_reauth,json=fastReauth,proto3,oneof" json:"fast_reauth,omitempty"`
+	ApScan          *uint32                `protobuf:"varint,6,opt,name=ap_scan,json=apScan,proto3,oneof" json:"ap_scan,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *InterfaceOptions) Reset() {
+	*x = InterfaceOptions{}
+	mi := &file_proto_ether8021x_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceOptions) ProtoMessage() {}
+
+func (x *InterfaceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceOptions.ProtoReflect.Descriptor instead.
+func (*InterfaceOptions) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *InterfaceOptions) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *InterfaceOptions) GetBridgeInterface() string {
+	if x != nil {
+		return x.BridgeInterface
+	}
+	return ""
+}
+
+func (x *InterfaceOptions) GetConfigFile() string {
+	if x != nil {
+		return x.ConfigFile
+	}
+	return ""
+}
+
+func (x *InterfaceOptions) GetEapolVersion() uint32 {
+	if x != nil {
+		return x.EapolVersion
+	}
+	return 0
+}
+
+func (x *InterfaceOptions) GetFastReauth() bool {
+	if x != nil && x.FastReauth != nil {
+		return *x.FastReauth
+	}
+	return false
+}
+
+func (x *InterfaceOptions) GetApScan() uint32 {
+	if x != nil && x.ApScan != nil {
+		return *x.ApScan
+	}
+	return 0
+}
+
+type MacsecConfig struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          MacsecMode             `protobuf:"varint,1,opt,name=mode,proto3,enum=ether8021x.MacsecMode" json:"mode,omitempty"`
+	MustSecure    bool                   `protobuf:"varint,2,opt,name=must_secure,json=mustSecure,proto3" json:"must_secure,omitempty"`
+	IntegrityOnly bool                   `protobuf:"varint,3,opt,name=integrity_only,json=integrityOnly,proto3" json:"integrity_only,omitempty"`
+	MkaCak        []byte                 `protobuf:"bytes,4,opt,name=mka_cak,json=mkaCak,proto3" json:"mka_cak,omitempty"`
+	MkaCkn        []byte                 `protobuf:"bytes,5,opt,name=mka_ckn,json=mkaCkn,proto3" json:"mka_ckn,omitempty"`
+	MkaPriority   *uint32                `protobuf:"varint,6,opt,name=mka_priority,json=mkaPriority,proto3,oneof" json:"mka_priority,omitempty"`
+	Port          uint32                 `protobuf:"varint,7,opt,name=port,proto3" json:"port,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MacsecConfig) Reset() {
+	*x = MacsecConfig{}
+	mi := &file_proto_ether8021x_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MacsecConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MacsecConfig) ProtoMessage() {}
+
+func (x *MacsecConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MacsecConfig.ProtoReflect.Descriptor instead.
+func (*MacsecConfig) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MacsecConfig) GetMode() MacsecMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MacsecMode_MACSEC_MODE_DISABLED
+}
+
+func (x *MacsecConfig) GetMustSecure() bool {
+	if x != nil {
+		return x.MustSecure
+	}
+	return false
+}
+
+func (x *MacsecConfig) GetIntegrityOnly() bool {
+	if x != nil {
+		return x.IntegrityOnly
+	}
+	return false
+}
+
+func (x *MacsecConfig) GetMkaCak() []byte {
+	if x != nil {
+		return x.MkaCak
+	}
+	return nil
+}
+
+func (x *MacsecConfig) GetMkaCkn() []byte {
+	if x != nil {
+		return x.MkaCkn
+	}
+	return nil
+}
+
+func (x *MacsecConfig) GetMkaPriority() uint32 {
+	if x != nil && x.MkaPriority != nil {
+		return *x.MkaPriority
+	}
+	return 0
+}
+
+func (x *MacsecConfig) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type Phase1Options struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PeapVersion   PeapVersion            `protobuf:"varint,1,opt,name=peap_version,json=peapVersion,proto3,enum=ether8021x.PeapVersion" json:"peap_version,omitempty"`
+	TlsMinVersion TlsVersion             `protobuf:"varint,2,opt,name=tls_min_version,json=tlsMinVersion,proto3,enum=ether8021x.TlsVersion" json:"tls_min_version,omitempty"`
+	TlsMaxVersion TlsVersion             `protobuf:"varint,3,opt,name=tls_max_version,json=tlsMaxVersion,proto3,enum=ether8021x.TlsVersion" json:"tls_max_version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Phase1Options) Reset() {
+	*x = Phase1Options{}
+	mi := &file_proto_ether8021x_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Phase1Options) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Phase1Options) ProtoMessage() {}
+
+func (x *Phase1Options) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Phase1Options.ProtoReflect.Descriptor instead.
+func (*Phase1Options) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Phase1Options) GetPeapVersion() PeapVersion {
+	if x != nil {
+		return x.PeapVersion
+	}
+	return PeapVersion_PEAP_VERSION_AUTO
+}
+
+func (x *Phase1Options) GetTlsMinVersion() TlsVersion {
+	if x != nil {
+		return x.TlsMinVersion
+	}
+	return TlsVersion_TLS_VERSION_UNSPECIFIED
+}
+
+func (x *Phase1Options) GetTlsMaxVersion() TlsVersion {
+	if x != nil {
+		return x.TlsMaxVersion
+	}
+	return TlsVersion_TLS_VERSION_UNSPECIFIED
+}
+
+type Dot1XConfigResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	FieldErrors   []*FieldError          `protobuf:"bytes,3,rep,name=field_errors,json=fieldErrors,proto3" json:"field_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dot1XConfigResponse) Reset() {
+	*x = Dot1XConfigResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dot1XConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dot1XConfigResponse) ProtoMessage() {}
+
+func (x *Dot1XConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dot1XConfigResponse.ProtoReflect.Descriptor instead.
+func (*Dot1XConfigResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Dot1XConfigResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Dot1XConfigResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Dot1XConfigResponse) GetFieldErrors() []*FieldError {
+	if x != nil {
+		return x.FieldErrors
+	}
+	return nil
+}
+
+type FieldError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FieldError) Reset() {
+	*x = FieldError{}
+	mi := &file_proto_ether8021x_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FieldError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldError) ProtoMessage() {}
+
+func (x *FieldError) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldError.ProtoReflect.Descriptor instead.
+func (*FieldError) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FieldError) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *FieldError) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ConfigureAndWatchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Config         *Dot1XConfigRequest    `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	TimeoutSeconds uint32                 `protobuf:"varint,2,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	WaitForIp      bool                   `protobuf:"varint,3,opt,name=wait_for_ip,json=waitForIp,proto3" json:"wait_for_ip,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ConfigureAndWatchRequest) Reset() {
+	*x = ConfigureAndWatchRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureAndWatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureAndWatchRequest) ProtoMessage() {}
+
+func (x *ConfigureAndWatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureAndWatchRequest.ProtoReflect.Descriptor instead.
+func (*ConfigureAndWatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ConfigureAndWatchRequest) GetConfig() *Dot1XConfigRequest {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+func (x *ConfigureAndWatchRequest) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *ConfigureAndWatchRequest) GetWaitForIp() bool {
+	if x != nil {
+		return x.WaitForIp
+	}
+	return false
+}
+
+type ConfigureProgress struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Stage         ConfigureStage         `protobuf:"varint,2,opt,name=stage,proto3,enum=ether8021x.ConfigureStage" json:"stage,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Terminal      bool                   `protobuf:"varint,4,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigureProgress) Reset() {
+	*x = ConfigureProgress{}
+	mi := &file_proto_ether8021x_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigureProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigureProgress) ProtoMessage() {}
+
+func (x *ConfigureProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigureProgress.ProtoReflect.Descriptor instead.
+func (*ConfigureProgress) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ConfigureProgress) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *ConfigureProgress) GetStage() ConfigureStage {
+	if x != nil {
+		return x.Stage
+	}
+	return ConfigureStage_CONFIGURE_STAGE_UNKNOWN
+}
+
+func (x *ConfigureProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigureProgress) GetTerminal() bool {
+	if x != nil {
+		return x.Terminal
+	}
+	return false
+}
+
+func (x *ConfigureProgress) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfigureProgress) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type InterfaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InterfaceRequest) Reset() {
+	*x = InterfaceRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceRequest) ProtoMessage() {}
+
+func (x *InterfaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceRequest.ProtoReflect.Descriptor instead.
+func (*InterfaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *InterfaceRequest) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+type StatusFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces    []string               `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatusFilter) Reset() {
+	*x = StatusFilter{}
+	mi := &file_proto_ether8021x_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatusFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatusFilter) ProtoMessage() {}
+
+func (x *StatusFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatusFilter.ProtoReflect.Descriptor instead.
+func (*StatusFilter) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *StatusFilter) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type InterfaceStatus struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Interface         string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Status            string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	EapState          string                 `protobuf:"bytes,3,opt,name=eap_state,json=eapState,proto3" json:"eap_state,omitempty"`
+	LastEvent         string                 `protobuf:"bytes,4,opt,name=last_event,json=lastEvent,proto3" json:"last_event,omitempty"`
+	IpAddress         string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Timestamp         int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SupplicantState   SupplicantState        `protobuf:"varint,7,opt,name=supplicant_state,json=supplicantState,proto3,enum=ether8021x.SupplicantState" json:"supplicant_state,omitempty"`
+	EapStatus         EapState               `protobuf:"varint,8,opt,name=eap_status,json=eapStatus,proto3,enum=ether8021x.EapState" json:"eap_status,omitempty"`
+	CurrentNetwork    string                 `protobuf:"bytes,9,opt,name=current_network,json=currentNetwork,proto3" json:"current_network,omitempty"`
+	AuthMode          string                 `protobuf:"bytes,10,opt,name=auth_mode,json=authMode,proto3" json:"auth_mode,omitempty"`
+	Ipv4Addresses     []string               `protobuf:"bytes,11,rep,name=ipv4_addresses,json=ipv4Addresses,proto3" json:"ipv4_addresses,omitempty"`
+	Ipv6Addresses     []string               `protobuf:"bytes,12,rep,name=ipv6_addresses,json=ipv6Addresses,proto3" json:"ipv6_addresses,omitempty"`
+	Carrier           bool                   `protobuf:"varint,13,opt,name=carrier,proto3" json:"carrier,omitempty"`
+	MacAddress        string                 `protobuf:"bytes,14,opt,name=mac_address,json=macAddress,proto3" json:"mac_address,omitempty"`
+	OperState         string                 `protobuf:"bytes,15,opt,name=oper_state,json=operState,proto3" json:"oper_state,omitempty"`
+	EventDetail       string                 `protobuf:"bytes,16,opt,name=event_detail,json=eventDetail,proto3" json:"event_detail,omitempty"`
+	EapType           EapType                `protobuf:"varint,17,opt,name=eap_type,json=eapType,proto3,enum=ether8021x.EapType" json:"eap_type,omitempty"`
+	Identity          string                 `protobuf:"bytes,18,opt,name=identity,proto3" json:"identity,omitempty"`
+	AnonymousIdentity string                 `protobuf:"bytes,19,opt,name=anonymous_identity,json=anonymousIdentity,proto3" json:"anonymous_identity,omitempty"`
+	Macsec            *MacsecStatus          `protobuf:"bytes,20,opt,name=macsec,proto3" json:"macsec,omitempty"`
+	InterfaceOptions  *InterfaceOptions      `protobuf:"bytes,21,opt,name=interface_options,json=interfaceOptions,proto3" json:"interface_options,omitempty"`
+	Wireless          *WirelessStatus        `protobuf:"bytes,22,opt,name=wireless,proto3" json:"wireless,omitempty"`
+	CredentialChain   *CredentialChainStatus `protobuf:"bytes,23,opt,name=credential_chain,json=credentialChain,proto3" json:"credential_chain,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *InterfaceStatus) Reset() {
+	*x = InterfaceStatus{}
+	mi := &file_proto_ether8021x_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InterfaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InterfaceStatus) ProtoMessage() {}
+
+func (x *InterfaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InterfaceStatus.ProtoReflect.Descriptor instead.
+func (*InterfaceStatus) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *InterfaceStatus) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetEapState() string {
+	if x != nil {
+		return x.EapState
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetLastEvent() string {
+	if x != nil {
+		return x.LastEvent
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *InterfaceStatus) GetSupplicantState() SupplicantState {
+	if x != nil {
+		return x.SupplicantState
+	}
+	return SupplicantState_SUPPLICANT_STATE_UNKNOWN
+}
+
+func (x *InterfaceStatus) GetEapStatus() EapState {
+	if x != nil {
+		return x.EapStatus
+	}
+	return EapState_EAP_STATE_UNKNOWN
+}
+
+func (x *InterfaceStatus) GetCurrentNetwork() string {
+	if x != nil {
+		return x.CurrentNetwork
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetAuthMode() string {
+	if x != nil {
+		return x.AuthMode
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetIpv4Addresses() []string {
+	if x != nil {
+		return x.Ipv4Addresses
+	}
+	return nil
+}
+
+func (x *InterfaceStatus) GetIpv6Addresses() []string {
+	if x != nil {
+		return x.Ipv6Addresses
+	}
+	return nil
+}
+
+func (x *InterfaceStatus) GetCarrier() bool {
+	if x != nil {
+		return x.Carrier
+	}
+	return false
+}
+
+func (x *InterfaceStatus) GetMacAddress() string {
+	if x != nil {
+		return x.MacAddress
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetOperState() string {
+	if x != nil {
+		return x.OperState
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetEventDetail() string {
+	if x != nil {
+		return x.EventDetail
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetEapType() EapType {
+	if x != nil {
+		return x.EapType
+	}
+	return EapType_EAP_UNKNOWN
+}
+
+func (x *InterfaceStatus) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetAnonymousIdentity() string {
+	if x != nil {
+		return x.AnonymousIdentity
+	}
+	return ""
+}
+
+func (x *InterfaceStatus) GetMacsec() *MacsecStatus {
+	if x != nil {
+		return x.Macsec
+	}
+	return nil
+}
+
+func (x *InterfaceStatus) GetInterfaceOptions() *InterfaceOptions {
+	if x != nil {
+		return x.InterfaceOptions
+	}
+	return nil
+}
+
+func (x *InterfaceStatus) GetWireless() *WirelessStatus {
+	if x != nil {
+		return x.Wireless
+	}
+	return nil
+}
+
+func (x *InterfaceStatus) GetCredentialChain() *CredentialChainStatus {
+	if x != nil {
+		return x.CredentialChain
+	}
+	return nil
+}
+
+type WirelessStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ssid          string                 `protobuf:"bytes,1,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	Bssid         string                 `protobuf:"bytes,2,opt,name=bssid,proto3" json:"bssid,omitempty"`
+	Frequency     uint32                 `protobuf:"varint,3,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	Signal        int32                  `protobuf:"varint,4,opt,name=signal,proto3" json:"signal,omitempty"`
+	Associated    bool                   `protobuf:"varint,5,opt,name=associated,proto3" json:"associated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WirelessStatus) Reset() {
+	*x = WirelessStatus{}
+	mi := &file_proto_ether8021x_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WirelessStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WirelessStatus) ProtoMessage() {}
+
+func (x *WirelessStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WirelessStatus.ProtoReflect.Descriptor instead.
+func (*WirelessStatus) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WirelessStatus) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *WirelessStatus) GetBssid() string {
+	if x != nil {
+		return x.Bssid
+	}
+	return ""
+}
+
+func (x *WirelessStatus) GetFrequency() uint32 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *WirelessStatus) GetSignal() int32 {
+	if x != nil {
+		return x.Signal
+	}
+	return 0
+}
+
+func (x *WirelessStatus) GetAssociated() bool {
+	if x != nil {
+		return x.Associated
+	}
+	return false
+}
+
+type CredentialChainStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Active        uint32                 `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	Entries       uint32                 `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	Authenticated bool                   `protobuf:"varint,3,opt,name=authenticated,proto3" json:"authenticated,omitempty"`
+	Failed        []uint32               `protobuf:"varint,4,rep,packed,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CredentialChainStatus) Reset() {
+	*x = CredentialChainStatus{}
+	mi := &file_proto_ether8021x_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CredentialChainStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CredentialChainStatus) ProtoMessage() {}
+
+func (x *CredentialChainStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CredentialChainStatus.ProtoReflect.Descriptor instead.
+func (*CredentialChainStatus) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CredentialChainStatus) GetActive() uint32 {
+	if x != nil {
+		return x.Active
+	}
+	return 0
+}
+
+func (x *CredentialChainStatus) GetEntries() uint32 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *CredentialChainStatus) GetAuthenticated() bool {
+	if x != nil {
+		return x.Authenticated
+	}
+	return false
+}
+
+func (x *CredentialChainStatus) GetFailed() []uint32 {
+	if x != nil {
+		return x.Failed
+	}
+	return nil
+}
+
+type MacsecStatus struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mode          MacsecMode             `protobuf:"varint,1,opt,name=mode,proto3,enum=ether8021x.MacsecMode" json:"mode,omitempty"`
+	Driver        string                 `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
+	Interface     string                 `protobuf:"bytes,3,opt,name=interface,proto3" json:"interface,omitempty"`
+	Secured       bool                   `protobuf:"varint,4,opt,name=secured,proto3" json:"secured,omitempty"`
+	OperState     string                 `protobuf:"bytes,5,opt,name=oper_state,json=operState,proto3" json:"oper_state,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MacsecStatus) Reset() {
+	*x = MacsecStatus{}
+	mi := &file_proto_ether8021x_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MacsecStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MacsecStatus) ProtoMessage() {}
+
+func (x *MacsecStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MacsecStatus.ProtoReflect.Descriptor instead.
+func (*MacsecStatus) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MacsecStatus) GetMode() MacsecMode {
+	if x != nil {
+		return x.Mode
+	}
+	return MacsecMode_MACSEC_MODE_DISABLED
+}
+
+func (x *MacsecStatus) GetDriver() string {
+	if x != nil {
+		return x.Driver
+	}
+	return ""
+}
+
+func (x *MacsecStatus) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *MacsecStatus) GetSecured() bool {
+	if x != nil {
+		return x.Secured
+	}
+	return false
+}
+
+func (x *MacsecStatus) GetOperState() string {
+	if x != nil {
+		return x.OperState
+	}
+	return ""
+}
+
+type DisconnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectResponse) Reset() {
+	*x = DisconnectResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectResponse) ProtoMessage() {}
+
+func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DisconnectResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DisconnectResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ReauthenticateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReauthenticateResponse) Reset() {
+	*x = ReauthenticateResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReauthenticateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReauthenticateResponse) ProtoMessage() {}
+
+func (x *ReauthenticateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReauthenticateResponse.ProtoReflect.Descriptor instead.
+func (*ReauthenticateResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReauthenticateResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReauthenticateResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EapolLogoffResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EapolLogoffResponse) Reset() {
+	*x = EapolLogoffResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EapolLogoffResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapolLogoffResponse) ProtoMessage() {}
+
+func (x *EapolLogoffResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapolLogoffResponse.ProtoReflect.Descriptor instead.
+func (*EapolLogoffResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *EapolLogoffResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EapolLogoffResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EapolLogonResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EapolLogonResponse) Reset() {
+	*x = EapolLogonResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EapolLogonResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapolLogonResponse) ProtoMessage() {}
+
+func (x *EapolLogonResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapolLogonResponse.ProtoReflect.Descriptor instead.
+func (*EapolLogonResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *EapolLogonResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *EapolLogonResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type EapEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Type          EapEventType           `protobuf:"varint,2,opt,name=type,proto3,enum=ether8021x.EapEventType" json:"type,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	Parameter     string                 `protobuf:"bytes,4,opt,name=parameter,proto3" json:"parameter,omitempty"`
+	Timestamp     int64                  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EapEvent) Reset() {
+	*x = EapEvent{}
+	mi := &file_proto_ether8021x_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EapEvent) ProtoMessage() {}
+
+func (x *EapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EapEvent.ProtoReflect.Descriptor instead.
+func (*EapEvent) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EapEvent) GetInterface() string {
+	if x != nil {
+		return x.Interface
+	}
+	return ""
+}
+
+func (x *EapEvent) GetType() EapEventType {
+	if x != nil {
+		return x.Type
+	}
+	return EapEventType_EAP_EVENT_UNKNOWN
+}
+
+func (x *EapEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *EapEvent) GetParameter() string {
+	if x != nil {
+		return x.Parameter
+	}
+	return ""
+}
+
+func (x *EapEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type ListCertificatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Interfaces    []string               `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCertificatesRequest) Reset() {
+	*x = ListCertificatesRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificatesRequest) ProtoMessage() {}
+
+func (x *ListCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*ListCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListCertificatesRequest) GetInterfaces() []string {
+	if x != nil {
+		return x.Interfaces
+	}
+	return nil
+}
+
+type ListCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Certificates  []*CertificateInfo     `protobuf:"bytes,1,rep,name=certificates,proto3" json:"certificates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCertificatesResponse) Reset() {
+	*x = ListCertificatesResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCertificatesResponse) ProtoMessage() {}
+
+func (x *ListCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x EapType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+// Deprecated: Use ListCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*ListCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{21}
 }
 
-// Deprecated: Use EapType.Descriptor instead.
-func (EapType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_ether8021x_proto_rawDescGZIP(), []int{0}
+func (x *ListCertificatesResponse) GetCertificates() []*CertificateInfo {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
 }
 
-type Dot1XConfigRequest struct {
-	state              protoimpl.MessageState `protogen:"open.v1"`
-	Interface          string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
-	EapType            EapType                `protobuf:"varint,2,opt,name=eap_type,json=eapType,proto3,enum=ether8021x.EapType" json:"eap_type,omitempty"`
-	Identity           string                 `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
-	Password           string                 `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	Phase2Auth         string                 `protobuf:"bytes,5,opt,name=phase2_auth,json=phase2Auth,proto3" json:"phase2_auth,omitempty"`
-	CaCert             []byte                 `protobuf:"bytes,6,opt,name=ca_cert,json=caCert,proto3" json:"ca_cert,omitempty"`
-	ClientCert         []byte                 `protobuf:"bytes,7,opt,name=client_cert,json=clientCert,proto3" json:"client_cert,omitempty"`
-	PrivateKey         []byte                 `protobuf:"bytes,8,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
-	PrivateKeyPassword string                 `protobuf:"bytes,9,opt,name=private_key_password,json=privateKeyPassword,proto3" json:"private_key_password,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+type CertificateInfo struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Subject           string                 `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer            string                 `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Serial            string                 `protobuf:"bytes,3,opt,name=serial,proto3" json:"serial,omitempty"`
+	NotBefore         int64                  `protobuf:"varint,4,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`
+	NotAfter          int64                  `protobuf:"varint,5,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`
+	Role              CertificateRole        `protobuf:"varint,6,opt,name=role,proto3,enum=ether8021x.CertificateRole" json:"role,omitempty"`
+	Interfaces        []string               `protobuf:"bytes,7,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	FingerprintSha256 string                 `protobuf:"bytes,8,opt,name=fingerprint_sha256,json=fingerprintSha256,proto3" json:"fingerprint_sha256,omitempty"`
+	Expiring          bool                   `protobuf:"varint,9,opt,name=expiring,proto3" json:"expiring,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *Dot1XConfigRequest) Reset() {
-	*x = Dot1XConfigRequest{}
-	mi := &file_proto_ether8021x_proto_msgTypes[0]
+func (x *CertificateInfo) Reset() {
+	*x = CertificateInfo{}
+	mi := &file_proto_ether8021x_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Dot1XConfigRequest) String() string {
+func (x *CertificateInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dot1XConfigRequest) ProtoMessage() {}
+func (*CertificateInfo) ProtoMessage() {}
 
-func (x *Dot1XConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ether8021x_proto_msgTypes[0]
+func (x *CertificateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -116,97 +2526,96 @@ func (x *Dot1XConfigRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dot1XConfigRequest.ProtoReflect.Descriptor instead.
-func (*Dot1XConfigRequest) Descriptor() ([]byte, []int) {
-	return file_proto_ether8021x_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use CertificateInfo.ProtoReflect.Descriptor instead.
+func (*CertificateInfo) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *Dot1XConfigRequest) GetInterface() string {
+func (x *CertificateInfo) GetSubject() string {
 	if x != nil {
-		return x.Interface
+		return x.Subject
 	}
 	return ""
 }
 
-func (x *Dot1XConfigRequest) GetEapType() EapType {
+func (x *CertificateInfo) GetIssuer() string {
 	if x != nil {
-		return x.EapType
+		return x.Issuer
 	}
-	return EapType_EAP_UNKNOWN
+	return ""
 }
 
-func (x *Dot1XConfigRequest) GetIdentity() string {
+func (x *CertificateInfo) GetSerial() string {
 	if x != nil {
-		return x.Identity
+		return x.Serial
 	}
 	return ""
 }
 
-func (x *Dot1XConfigRequest) GetPassword() string {
+func (x *CertificateInfo) GetNotBefore() int64 {
 	if x != nil {
-		return x.Password
+		return x.NotBefore
 	}
-	return ""
+	return 0
 }
 
-func (x *Dot1XConfigRequest) GetPhase2Auth() string {
+func (x *CertificateInfo) GetNotAfter() int64 {
 	if x != nil {
-		return x.Phase2Auth
+		return x.NotAfter
 	}
-	return ""
+	return 0
 }
 
-func (x *Dot1XConfigRequest) GetCaCert() []byte {
+func (x *CertificateInfo) GetRole() CertificateRole {
 	if x != nil {
-		return x.CaCert
+		return x.Role
 	}
-	return nil
+	return CertificateRole_CERTIFICATE_ROLE_UNKNOWN
 }
 
-func (x *Dot1XConfigRequest) GetClientCert() []byte {
+func (x *CertificateInfo) GetInterfaces() []string {
 	if x != nil {
-		return x.ClientCert
+		return x.Interfaces
 	}
 	return nil
 }
 
-func (x *Dot1XConfigRequest) GetPrivateKey() []byte {
+func (x *CertificateInfo) GetFingerprintSha256() string {
 	if x != nil {
-		return x.PrivateKey
+		return x.FingerprintSha256
 	}
-	return nil
+	return ""
 }
 
-func (x *Dot1XConfigRequest) GetPrivateKeyPassword() string {
+func (x *CertificateInfo) GetExpiring() bool {
 	if x != nil {
-		return x.PrivateKeyPassword
+		return x.Expiring
 	}
-	return ""
+	return false
 }
 
-type Dot1XConfigResponse struct {
+type ListPacsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interfaces    []string               `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Dot1XConfigResponse) Reset() {
-	*x = Dot1XConfigResponse{}
-	mi := &file_proto_ether8021x_proto_msgTypes[1]
+func (x *ListPacsRequest) Reset() {
+	*x = ListPacsRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Dot1XConfigResponse) String() string {
+func (x *ListPacsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Dot1XConfigResponse) ProtoMessage() {}
+func (*ListPacsRequest) ProtoMessage() {}
 
-func (x *Dot1XConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ether8021x_proto_msgTypes[1]
+func (x *ListPacsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -217,47 +2626,40 @@ func (x *Dot1XConfigResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Dot1XConfigResponse.ProtoReflect.Descriptor instead.
-func (*Dot1XConfigResponse) Descriptor() ([]byte, []int) {
-	return file_proto_ether8021x_proto_rawDescGZIP(), []int{1}
-}
-
-func (x *Dot1XConfigResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
+// Deprecated: Use ListPacsRequest.ProtoReflect.Descriptor instead.
+func (*ListPacsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *Dot1XConfigResponse) GetMessage() string {
+func (x *ListPacsRequest) GetInterfaces() []string {
 	if x != nil {
-		return x.Message
+		return x.Interfaces
 	}
-	return ""
+	return nil
 }
 
-type InterfaceRequest struct {
+type ListPacsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
+	Pacs          []*PacInfo             `protobuf:"bytes,1,rep,name=pacs,proto3" json:"pacs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceRequest) Reset() {
-	*x = InterfaceRequest{}
-	mi := &file_proto_ether8021x_proto_msgTypes[2]
+func (x *ListPacsResponse) Reset() {
+	*x = ListPacsResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceRequest) String() string {
+func (x *ListPacsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceRequest) ProtoMessage() {}
+func (*ListPacsResponse) ProtoMessage() {}
 
-func (x *InterfaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ether8021x_proto_msgTypes[2]
+func (x *ListPacsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,45 +2670,45 @@ func (x *InterfaceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceRequest.ProtoReflect.Descriptor instead.
-func (*InterfaceRequest) Descriptor() ([]byte, []int) {
-	return file_proto_ether8021x_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use ListPacsResponse.ProtoReflect.Descriptor instead.
+func (*ListPacsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *InterfaceRequest) GetInterface() string {
+func (x *ListPacsResponse) GetPacs() []*PacInfo {
 	if x != nil {
-		return x.Interface
+		return x.Pacs
 	}
-	return ""
+	return nil
 }
 
-type InterfaceStatus struct {
+type PacInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Interface     string                 `protobuf:"bytes,1,opt,name=interface,proto3" json:"interface,omitempty"`
-	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	EapState      string                 `protobuf:"bytes,3,opt,name=eap_state,json=eapState,proto3" json:"eap_state,omitempty"`
-	LastEvent     string                 `protobuf:"bytes,4,opt,name=last_event,json=lastEvent,proto3" json:"last_event,omitempty"`
-	IpAddress     string                 `protobuf:"bytes,5,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
-	Timestamp     int64                  `protobuf:"varint,6,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	PacType       uint32                 `protobuf:"varint,2,opt,name=pac_type,json=pacType,proto3" json:"pac_type,omitempty"`
+	AId           string                 `protobuf:"bytes,3,opt,name=a_id,json=aId,proto3" json:"a_id,omitempty"`
+	AIdInfo       string                 `protobuf:"bytes,4,opt,name=a_id_info,json=aIdInfo,proto3" json:"a_id_info,omitempty"`
+	IId           string                 `protobuf:"bytes,5,opt,name=i_id,json=iId,proto3" json:"i_id,omitempty"`
+	Modified      int64                  `protobuf:"varint,6,opt,name=modified,proto3" json:"modified,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InterfaceStatus) Reset() {
-	*x = InterfaceStatus{}
-	mi := &file_proto_ether8021x_proto_msgTypes[3]
+func (x *PacInfo) Reset() {
+	*x = PacInfo{}
+	mi := &file_proto_ether8021x_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InterfaceStatus) String() string {
+func (x *PacInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InterfaceStatus) ProtoMessage() {}
+func (*PacInfo) ProtoMessage() {}
 
-func (x *InterfaceStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ether8021x_proto_msgTypes[3]
+func (x *PacInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -317,76 +2719,75 @@ func (x *InterfaceStatus) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InterfaceStatus.ProtoReflect.Descriptor instead.
-func (*InterfaceStatus) Descriptor() ([]byte, []int) {
-	return file_proto_ether8021x_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use PacInfo.ProtoReflect.Descriptor instead.
+func (*PacInfo) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *InterfaceStatus) GetInterface() string {
+func (x *PacInfo) GetInterface() string {
 	if x != nil {
 		return x.Interface
 	}
 	return ""
 }
 
-func (x *InterfaceStatus) GetStatus() string {
+func (x *PacInfo) GetPacType() uint32 {
 	if x != nil {
-		return x.Status
+		return x.PacType
 	}
-	return ""
+	return 0
 }
 
-func (x *InterfaceStatus) GetEapState() string {
+func (x *PacInfo) GetAId() string {
 	if x != nil {
-		return x.EapState
+		return x.AId
 	}
 	return ""
 }
 
-func (x *InterfaceStatus) GetLastEvent() string {
+func (x *PacInfo) GetAIdInfo() string {
 	if x != nil {
-		return x.LastEvent
+		return x.AIdInfo
 	}
 	return ""
 }
 
-func (x *InterfaceStatus) GetIpAddress() string {
+func (x *PacInfo) GetIId() string {
 	if x != nil {
-		return x.IpAddress
+		return x.IId
 	}
 	return ""
 }
 
-func (x *InterfaceStatus) GetTimestamp() int64 {
+func (x *PacInfo) GetModified() int64 {
 	if x != nil {
-		return x.Timestamp
+		return x.Modified
 	}
 	return 0
 }
 
-type DisconnectResponse struct {
+type ClearPacsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Interfaces    []string               `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectResponse) Reset() {
-	*x = DisconnectResponse{}
-	mi := &file_proto_ether8021x_proto_msgTypes[4]
+func (x *ClearPacsRequest) Reset() {
+	*x = ClearPacsRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectResponse) String() string {
+func (x *ClearPacsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectResponse) ProtoMessage() {}
+func (*ClearPacsRequest) ProtoMessage() {}
 
-func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_ether8021x_proto_msgTypes[4]
+func (x *ClearPacsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -397,23 +2798,148 @@ func (x *DisconnectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectResponse) Descriptor() ([]byte, []int) {
-	return file_proto_ether8021x_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use ClearPacsRequest.ProtoReflect.Descriptor instead.
+func (*ClearPacsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *DisconnectResponse) GetSuccess() bool {
+func (x *ClearPacsRequest) GetInterfaces() []string {
 	if x != nil {
-		return x.Success
+		return x.Interfaces
 	}
-	return false
+	return nil
 }
 
-func (x *DisconnectResponse) GetMessage() string {
+type ClearPacsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Cleared       []string               `protobuf:"bytes,1,rep,name=cleared,proto3" json:"cleared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClearPacsResponse) Reset() {
+	*x = ClearPacsResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClearPacsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClearPacsResponse) ProtoMessage() {}
+
+func (x *ClearPacsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[27]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClearPacsResponse.ProtoReflect.Descriptor instead.
+func (*ClearPacsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ClearPacsResponse) GetCleared() []string {
+	if x != nil {
+		return x.Cleared
+	}
+	return nil
+}
+
+type ListEapMethodsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEapMethodsRequest) Reset() {
+	*x = ListEapMethodsRequest{}
+	mi := &file_proto_ether8021x_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEapMethodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEapMethodsRequest) ProtoMessage() {}
+
+func (x *ListEapMethodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEapMethodsRequest.ProtoReflect.Descriptor instead.
+func (*ListEapMethodsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{28}
+}
+
+type ListEapMethodsResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Supported         []EapType              `protobuf:"varint,1,rep,packed,name=supported,proto3,enum=ether8021x.EapType" json:"supported,omitempty"`
+	SupplicantMethods []string               `protobuf:"bytes,2,rep,name=supplicant_methods,json=supplicantMethods,proto3" json:"supplicant_methods,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListEapMethodsResponse) Reset() {
+	*x = ListEapMethodsResponse{}
+	mi := &file_proto_ether8021x_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEapMethodsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEapMethodsResponse) ProtoMessage() {}
+
+func (x *ListEapMethodsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_ether8021x_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEapMethodsResponse.ProtoReflect.Descriptor instead.
+func (*ListEapMethodsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_ether8021x_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListEapMethodsResponse) GetSupported() []EapType {
+	if x != nil {
+		return x.Supported
+	}
+	return nil
+}
+
+func (x *ListEapMethodsResponse) GetSupplicantMethods() []string {
+	if x != nil {
+		return x.SupplicantMethods
+	}
+	return nil
 }
 
 var File_proto_ether8021x_proto protoreflect.FileDescriptor
@@ -421,7 +2947,7 @@ var File_proto_ether8021x_proto protoreflect.FileDescriptor
 const file_proto_ether8021x_proto_rawDesc = "" +
 	"\n" +
 	"\x16proto/ether8021x.proto\x12\n" +
-	"ether8021x\"\xc8\x02\n" +
+	"ether8021x\"\x9f\b\n" +
 	"\x12Dot1xConfigRequest\x12\x1c\n" +
 	"\tinterface\x18\x01 \x01(\tR\tinterface\x12.\n" +
 	"\beap_type\x18\x02 \x01(\x0e2\x13.ether8021x.EapTypeR\aeapType\x12\x1a\n" +
@@ -434,12 +2960,81 @@ const file_proto_ether8021x_proto_rawDesc = "" +
 	"clientCert\x12\x1f\n" +
 	"\vprivate_key\x18\b \x01(\fR\n" +
 	"privateKey\x120\n" +
-	"\x14private_key_password\x18\t \x01(\tR\x12privateKeyPassword\"I\n" +
+	"\x14private_key_password\x18\t \x01(\tR\x12privateKeyPassword\x12\x16\n" +
+	"\x06pkcs12\x18\n" +
+	" \x01(\fR\x06pkcs12\x12'\n" +
+	"\x0fpkcs12_password\x18\v \x01(\tR\x0epkcs12Password\x12I\n" +
+	"\x11fast_provisioning\x18\f \x01(\x0e2\x1c.ether8021x.FastProvisioningR\x10fastProvisioning\x12\x10\n" +
+	"\x03pac\x18\r \x01(\fR\x03pac\x12.\n" +
+	"\x13domain_suffix_match\x18\x0e \x01(\tR\x11domainSuffixMatch\x12!\n" +
+	"\fdomain_match\x18\x0f \x01(\tR\vdomainMatch\x12)\n" +
+	"\x10altsubject_match\x18\x10 \x03(\tR\x0faltsubjectMatch\x12\x17\n" +
+	"\aca_path\x18\x11 \x01(\tR\x06caPath\x12\x19\n" +
+	"\bca_cert2\x18\x12 \x01(\fR\acaCert2\x121\n" +
+	"\x06phase1\x18\x13 \x01(\v2\x19.ether8021x.Phase1OptionsR\x06phase1\x12-\n" +
+	"\x12anonymous_identity\x18\x14 \x01(\tR\x11anonymousIdentity\x120\n" +
+	"\x06phase2\x18\x15 \x01(\x0e2\x18.ether8021x.Phase2MethodR\x06phase2\x120\n" +
+	"\x06macsec\x18\x16 \x01(\v2\x18.ether8021x.MacsecConfigR\x06macsec\x12I\n" +
+	"\x11interface_options\x18\x17 \x01(\v2\x1c.ether8021x.InterfaceOptionsR\x10interfaceOptions\x126\n" +
+	"\bwireless\x18\x18 \x01(\v2\x1a.ether8021x.WirelessConfigR\bwireless\x12<\n" +
+	"\tfallbacks\x18\x19 \x03(\v2\x1e.ether8021x.Dot1xConfigRequestR\tfallbacks\"\xc0\x01\n" +
+	"\x0eWirelessConfig\x12\x12\n" +
+	"\x04ssid\x18\x01 \x01(\tR\x04ssid\x124\n" +
+	"\bkey_mgmt\x18\x02 \x03(\x0e2\x19.ether8021x.KeyManagementR\akeyMgmt\x12%\n" +
+	"\x03pmf\x18\x03 \x01(\x0e2\x13.ether8021x.PmfModeR\x03pmf\x12 \n" +
+	"\vfrequencies\x18\x04 \x03(\rR\vfrequencies\x12\x1b\n" +
+	"\tscan_ssid\x18\x05 \x01(\bR\bscanSsid\"\xfb\x01\n" +
+	"\x10InterfaceOptions\x12\x16\n" +
+	"\x06driver\x18\x01 \x01(\tR\x06driver\x12)\n" +
+	"\x10bridge_interface\x18\x02 \x01(\tR\x0fbridgeInterface\x12\x1f\n" +
+	"\vconfig_file\x18\x03 \x01(\tR\n" +
+	"configFile\x12#\n" +
+	"\reapol_version\x18\x04 \x01(\rR\feapolVersion\x12$\n" +
+	"\vfast_reauth\x18\x05 \x01(\bH\x00R\n" +
+	"fastReauth\x88\x01\x01\x12\x1c\n" +
+	"\aap_scan\x18\x06 \x01(\rH\x01R\x06apScan\x88\x01\x01B\x0e\n" +
+	"\f_fast_reauthB\n" +
+	"\n" +
+	"\b_ap_scan\"\x81\x02\n" +
+	"\fMacsecConfig\x12*\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x16.ether8021x.MacsecModeR\x04mode\x12\x1f\n" +
+	"\vmust_secure\x18\x02 \x01(\bR\n" +
+	"mustSecure\x12%\n" +
+	"\x0eintegrity_only\x18\x03 \x01(\bR\rintegrityOnly\x12\x17\n" +
+	"\amka_cak\x18\x04 \x01(\fR\x06mkaCak\x12\x17\n" +
+	"\amka_ckn\x18\x05 \x01(\fR\x06mkaCkn\x12&\n" +
+	"\fmka_priority\x18\x06 \x01(\rH\x00R\vmkaPriority\x88\x01\x01\x12\x12\n" +
+	"\x04port\x18\a \x01(\rR\x04portB\x0f\n" +
+	"\r_mka_priority\"\xcb\x01\n" +
+	"\rPhase1Options\x12:\n" +
+	"\fpeap_version\x18\x01 \x01(\x0e2\x17.ether8021x.PeapVersionR\vpeapVersion\x12>\n" +
+	"\x0ftls_min_version\x18\x02 \x01(\x0e2\x16.ether8021x.TlsVersionR\rtlsMinVersion\x12>\n" +
+	"\x0ftls_max_version\x18\x03 \x01(\x0e2\x16.ether8021x.TlsVersionR\rtlsMaxVersion\"\x84\x01\n" +
 	"\x13Dot1xConfigResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"0\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x129\n" +
+	"\ffield_errors\x18\x03 \x03(\v2\x16.ether8021x.FieldErrorR\vfieldErrors\":\n" +
+	"\n" +
+	"FieldError\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x9b\x01\n" +
+	"\x18ConfigureAndWatchRequest\x126\n" +
+	"\x06config\x18\x01 \x01(\v2\x1e.ether8021x.Dot1xConfigRequestR\x06config\x12'\n" +
+	"\x0ftimeout_seconds\x18\x02 \x01(\rR\x0etimeoutSeconds\x12\x1e\n" +
+	"\vwait_for_ip\x18\x03 \x01(\bR\twaitForIp\"\xd1\x01\n" +
+	"\x11ConfigureProgress\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x120\n" +
+	"\x05stage\x18\x02 \x01(\x0e2\x1a.ether8021x.ConfigureStageR\x05stage\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1a\n" +
+	"\bterminal\x18\x04 \x01(\bR\bterminal\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x1c\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\"0\n" +
 	"\x10InterfaceRequest\x12\x1c\n" +
-	"\tinterface\x18\x01 \x01(\tR\tinterface\"\xc0\x01\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\".\n" +
+	"\fStatusFilter\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x01 \x03(\tR\n" +
+	"interfaces\"\xcc\a\n" +
 	"\x0fInterfaceStatus\x12\x1c\n" +
 	"\tinterface\x18\x01 \x01(\tR\tinterface\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
@@ -448,22 +3043,224 @@ const file_proto_ether8021x_proto_rawDesc = "" +
 	"last_event\x18\x04 \x01(\tR\tlastEvent\x12\x1d\n" +
 	"\n" +
 	"ip_address\x18\x05 \x01(\tR\tipAddress\x12\x1c\n" +
-	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\"H\n" +
+	"\ttimestamp\x18\x06 \x01(\x03R\ttimestamp\x12F\n" +
+	"\x10supplicant_state\x18\a \x01(\x0e2\x1b.ether8021x.SupplicantStateR\x0fsupplicantState\x123\n" +
+	"\n" +
+	"eap_status\x18\b \x01(\x0e2\x14.ether8021x.EapStateR\teapStatus\x12'\n" +
+	"\x0fcurrent_network\x18\t \x01(\tR\x0ecurrentNetwork\x12\x1b\n" +
+	"\tauth_mode\x18\n" +
+	" \x01(\tR\bauthMode\x12%\n" +
+	"\x0eipv4_addresses\x18\v \x03(\tR\ripv4Addresses\x12%\n" +
+	"\x0eipv6_addresses\x18\f \x03(\tR\ripv6Addresses\x12\x18\n" +
+	"\acarrier\x18\r \x01(\bR\acarrier\x12\x1f\n" +
+	"\vmac_address\x18\x0e \x01(\tR\n" +
+	"macAddress\x12\x1d\n" +
+	"\n" +
+	"oper_state\x18\x0f \x01(\tR\toperState\x12!\n" +
+	"\fevent_detail\x18\x10 \x01(\tR\veventDetail\x12.\n" +
+	"\beap_type\x18\x11 \x01(\x0e2\x13.ether8021x.EapTypeR\aeapType\x12\x1a\n" +
+	"\bidentity\x18\x12 \x01(\tR\bidentity\x12-\n" +
+	"\x12anonymous_identity\x18\x13 \x01(\tR\x11anonymousIdentity\x120\n" +
+	"\x06macsec\x18\x14 \x01(\v2\x18.ether8021x.MacsecStatusR\x06macsec\x12I\n" +
+	"\x11interface_options\x18\x15 \x01(\v2\x1c.ether8021x.InterfaceOptionsR\x10interfaceOptions\x126\n" +
+	"\bwireless\x18\x16 \x01(\v2\x1a.ether8021x.WirelessStatusR\bwireless\x12L\n" +
+	"\x10credential_chain\x18\x17 \x01(\v2!.ether8021x.CredentialChainStatusR\x0fcredentialChain\"\x90\x01\n" +
+	"\x0eWirelessStatus\x12\x12\n" +
+	"\x04ssid\x18\x01 \x01(\tR\x04ssid\x12\x14\n" +
+	"\x05bssid\x18\x02 \x01(\tR\x05bssid\x12\x1c\n" +
+	"\tfrequency\x18\x03 \x01(\rR\tfrequency\x12\x16\n" +
+	"\x06signal\x18\x04 \x01(\x05R\x06signal\x12\x1e\n" +
+	"\n" +
+	"associated\x18\x05 \x01(\bR\n" +
+	"associated\"\x87\x01\n" +
+	"\x15CredentialChainStatus\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\rR\x06active\x12\x18\n" +
+	"\aentries\x18\x02 \x01(\rR\aentries\x12$\n" +
+	"\rauthenticated\x18\x03 \x01(\bR\rauthenticated\x12\x16\n" +
+	"\x06failed\x18\x04 \x03(\rR\x06failed\"\xa9\x01\n" +
+	"\fMacsecStatus\x12*\n" +
+	"\x04mode\x18\x01 \x01(\x0e2\x16.ether8021x.MacsecModeR\x04mode\x12\x16\n" +
+	"\x06driver\x18\x02 \x01(\tR\x06driver\x12\x1c\n" +
+	"\tinterface\x18\x03 \x01(\tR\tinterface\x12\x18\n" +
+	"\asecured\x18\x04 \x01(\bR\asecured\x12\x1d\n" +
+	"\n" +
+	"oper_state\x18\x05 \x01(\tR\toperState\"H\n" +
 	"\x12DisconnectResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage*Q\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"L\n" +
+	"\x16ReauthenticateResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"I\n" +
+	"\x13EapolLogoffResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"H\n" +
+	"\x12EapolLogonResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xaa\x01\n" +
+	"\bEapEvent\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x12,\n" +
+	"\x04type\x18\x02 \x01(\x0e2\x18.ether8021x.EapEventTypeR\x04type\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1c\n" +
+	"\tparameter\x18\x04 \x01(\tR\tparameter\x12\x1c\n" +
+	"\ttimestamp\x18\x05 \x01(\x03R\ttimestamp\"9\n" +
+	"\x17ListCertificatesRequest\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x01 \x03(\tR\n" +
+	"interfaces\"[\n" +
+	"\x18ListCertificatesResponse\x12?\n" +
+	"\fcertificates\x18\x01 \x03(\v2\x1b.ether8021x.CertificateInfoR\fcertificates\"\xb3\x02\n" +
+	"\x0fCertificateInfo\x12\x18\n" +
+	"\asubject\x18\x01 \x01(\tR\asubject\x12\x16\n" +
+	"\x06issuer\x18\x02 \x01(\tR\x06issuer\x12\x16\n" +
+	"\x06serial\x18\x03 \x01(\tR\x06serial\x12\x1d\n" +
+	"\n" +
+	"not_before\x18\x04 \x01(\x03R\tnotBefore\x12\x1b\n" +
+	"\tnot_after\x18\x05 \x01(\x03R\bnotAfter\x12/\n" +
+	"\x04role\x18\x06 \x01(\x0e2\x1b.ether8021x.CertificateRoleR\x04role\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\a \x03(\tR\n" +
+	"interfaces\x12-\n" +
+	"\x12fingerprint_sha256\x18\b \x01(\tR\x11fingerprintSha256\x12\x1a\n" +
+	"\bexpiring\x18\t \x01(\bR\bexpiring\"1\n" +
+	"\x0fListPacsRequest\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x01 \x03(\tR\n" +
+	"interfaces\";\n" +
+	"\x10ListPacsResponse\x12'\n" +
+	"\x04pacs\x18\x01 \x03(\v2\x13.ether8021x.PacInfoR\x04pacs\"\xa0\x01\n" +
+	"\aPacInfo\x12\x1c\n" +
+	"\tinterface\x18\x01 \x01(\tR\tinterface\x12\x19\n" +
+	"\bpac_type\x18\x02 \x01(\rR\apacType\x12\x11\n" +
+	"\x04a_id\x18\x03 \x01(\tR\x03aId\x12\x1a\n" +
+	"\ta_id_info\x18\x04 \x01(\tR\aaIdInfo\x12\x11\n" +
+	"\x04i_id\x18\x05 \x01(\tR\x03iId\x12\x1a\n" +
+	"\bmodified\x18\x06 \x01(\x03R\bmodified\"2\n" +
+	"\x10ClearPacsRequest\x12\x1e\n" +
+	"\n" +
+	"interfaces\x18\x01 \x03(\tR\n" +
+	"interfaces\"-\n" +
+	"\x11ClearPacsResponse\x12\x18\n" +
+	"\acleared\x18\x01 \x03(\tR\acleared\"\x17\n" +
+	"\x15ListEapMethodsRequest\"z\n" +
+	"\x16ListEapMethodsResponse\x121\n" +
+	"\tsupported\x18\x01 \x03(\x0e2\x13.ether8021x.EapTypeR\tsupported\x12-\n" +
+	"\x12supplicant_methods\x18\x02 \x03(\tR\x11supplicantMethods*~\n" +
+	"\rKeyManagement\x12\x18\n" +
+	"\x14KEY_MGMT_UNSPECIFIED\x10\x00\x12\x14\n" +
+	"\x10KEY_MGMT_WPA_EAP\x10\x01\x12\x1b\n" +
+	"\x17KEY_MGMT_WPA_EAP_SHA256\x10\x02\x12 \n" +
+	"\x1cKEY_MGMT_WPA_EAP_SUITE_B_192\x10\x03*P\n" +
+	"\aPmfMode\x12\x0f\n" +
+	"\vPMF_DEFAULT\x10\x00\x12\x10\n" +
+	"\fPMF_DISABLED\x10\x01\x12\x10\n" +
+	"\fPMF_OPTIONAL\x10\x02\x12\x10\n" +
+	"\fPMF_REQUIRED\x10\x03*\xd0\x01\n" +
+	"\fPhase2Method\x12\x16\n" +
+	"\x12PHASE2_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPHASE2_MSCHAPV2\x10\x01\x12\x0e\n" +
+	"\n" +
+	"PHASE2_GTC\x10\x02\x12\x0e\n" +
+	"\n" +
+	"PHASE2_MD5\x10\x03\x12\x0e\n" +
+	"\n" +
+	"PHASE2_PAP\x10\x04\x12\x0f\n" +
+	"\vPHASE2_CHAP\x10\x05\x12\x11\n" +
+	"\rPHASE2_MSCHAP\x10\x06\x12\x17\n" +
+	"\x13PHASE2_EAP_MSCHAPV2\x10\a\x12\x12\n" +
+	"\x0ePHASE2_EAP_GTC\x10\b\x12\x12\n" +
+	"\x0ePHASE2_EAP_MD5\x10\t*P\n" +
+	"\n" +
+	"MacsecMode\x12\x18\n" +
+	"\x14MACSEC_MODE_DISABLED\x10\x00\x12\x13\n" +
+	"\x0fMACSEC_MODE_EAP\x10\x01\x12\x13\n" +
+	"\x0fMACSEC_MODE_PSK\x10\x02*L\n" +
+	"\vPeapVersion\x12\x15\n" +
+	"\x11PEAP_VERSION_AUTO\x10\x00\x12\x12\n" +
+	"\x0ePEAP_VERSION_0\x10\x01\x12\x12\n" +
+	"\x0ePEAP_VERSION_1\x10\x02*}\n" +
+	"\n" +
+	"TlsVersion\x12\x1b\n" +
+	"\x17TLS_VERSION_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fTLS_VERSION_1_0\x10\x01\x12\x13\n" +
+	"\x0fTLS_VERSION_1_1\x10\x02\x12\x13\n" +
+	"\x0fTLS_VERSION_1_2\x10\x03\x12\x13\n" +
+	"\x0fTLS_VERSION_1_3\x10\x04*\x9a\x01\n" +
+	"\x10FastProvisioning\x12\x1e\n" +
+	"\x1aFAST_PROVISIONING_DISABLED\x10\x00\x12%\n" +
+	"!FAST_PROVISIONING_UNAUTHENTICATED\x10\x01\x12#\n" +
+	"\x1fFAST_PROVISIONING_AUTHENTICATED\x10\x02\x12\x1a\n" +
+	"\x16FAST_PROVISIONING_BOTH\x10\x03*\x86\x01\n" +
 	"\aEapType\x12\x0f\n" +
 	"\vEAP_UNKNOWN\x10\x00\x12\v\n" +
 	"\aEAP_TLS\x10\x01\x12\f\n" +
 	"\bEAP_PEAP\x10\x02\x12\f\n" +
 	"\bEAP_TTLS\x10\x03\x12\f\n" +
-	"\bEAP_FAST\x10\x042\xc6\x02\n" +
+	"\bEAP_FAST\x10\x04\x12\f\n" +
+	"\bEAP_TEAP\x10\x05\x12\v\n" +
+	"\aEAP_PWD\x10\x06\x12\v\n" +
+	"\aEAP_MD5\x10\a\x12\v\n" +
+	"\aEAP_GTC\x10\b*\xbf\x02\n" +
+	"\x0eConfigureStage\x12\x1b\n" +
+	"\x17CONFIGURE_STAGE_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dCONFIGURE_STAGE_NETWORK_ADDED\x10\x01\x12$\n" +
+	" CONFIGURE_STAGE_NETWORK_SELECTED\x10\x02\x12!\n" +
+	"\x1dCONFIGURE_STAGE_EAPOL_STARTED\x10\x03\x12%\n" +
+	"!CONFIGURE_STAGE_METHOD_NEGOTIATED\x10\x04\x12!\n" +
+	"\x1dCONFIGURE_STAGE_AUTHENTICATED\x10\x05\x12\x1f\n" +
+	"\x1bCONFIGURE_STAGE_IP_ACQUIRED\x10\x06\x12\x1a\n" +
+	"\x16CONFIGURE_STAGE_FAILED\x10\a\x12\x1d\n" +
+	"\x19CONFIGURE_STAGE_TIMED_OUT\x10\b*\x8c\x03\n" +
+	"\x0fSupplicantState\x12\x1c\n" +
+	"\x18SUPPLICANT_STATE_UNKNOWN\x10\x00\x12!\n" +
+	"\x1dSUPPLICANT_STATE_DISCONNECTED\x10\x01\x12'\n" +
+	"#SUPPLICANT_STATE_INTERFACE_DISABLED\x10\x02\x12\x1d\n" +
+	"\x19SUPPLICANT_STATE_INACTIVE\x10\x03\x12\x1d\n" +
+	"\x19SUPPLICANT_STATE_SCANNING\x10\x04\x12#\n" +
+	"\x1fSUPPLICANT_STATE_AUTHENTICATING\x10\x05\x12 \n" +
+	"\x1cSUPPLICANT_STATE_ASSOCIATING\x10\x06\x12\x1f\n" +
+	"\x1bSUPPLICANT_STATE_ASSOCIATED\x10\a\x12#\n" +
+	"\x1fSUPPLICANT_STATE_4WAY_HANDSHAKE\x10\b\x12$\n" +
+	" SUPPLICANT_STATE_GROUP_HANDSHAKE\x10\t\x12\x1e\n" +
+	"\x1aSUPPLICANT_STATE_COMPLETED\x10\n" +
+	"*~\n" +
+	"\bEapState\x12\x15\n" +
+	"\x11EAP_STATE_UNKNOWN\x10\x00\x12\x12\n" +
+	"\x0eEAP_STATE_IDLE\x10\x01\x12\x19\n" +
+	"\x15EAP_STATE_IN_PROGRESS\x10\x02\x12\x15\n" +
+	"\x11EAP_STATE_SUCCESS\x10\x03\x12\x15\n" +
+	"\x11EAP_STATE_FAILURE\x10\x04*\xcc\x02\n" +
+	"\fEapEventType\x12\x15\n" +
+	"\x11EAP_EVENT_UNKNOWN\x10\x00\x12\x15\n" +
+	"\x11EAP_EVENT_STARTED\x10\x01\x12&\n" +
+	"\"EAP_EVENT_PROPOSED_METHOD_ACCEPTED\x10\x02\x12&\n" +
+	"\"EAP_EVENT_PROPOSED_METHOD_REJECTED\x10\x03\x12\x1d\n" +
+	"\x19EAP_EVENT_METHOD_SELECTED\x10\x04\x12\x1e\n" +
+	"\x1aEAP_EVENT_PARAMETER_NEEDED\x10\x05\x12&\n" +
+	"\"EAP_EVENT_REMOTE_CERT_VERIFICATION\x10\x06\x12\x1e\n" +
+	"\x1aEAP_EVENT_REMOTE_TLS_ALERT\x10\a\x12\x1d\n" +
+	"\x19EAP_EVENT_LOCAL_TLS_ALERT\x10\b\x12\x18\n" +
+	"\x14EAP_EVENT_COMPLETION\x10\t*e\n" +
+	"\x0fCertificateRole\x12\x1c\n" +
+	"\x18CERTIFICATE_ROLE_UNKNOWN\x10\x00\x12\x1b\n" +
+	"\x17CERTIFICATE_ROLE_CLIENT\x10\x01\x12\x17\n" +
+	"\x13CERTIFICATE_ROLE_CA\x10\x022\xee\b\n" +
 	"\fDot1xManager\x12U\n" +
 	"\x12ConfigureInterface\x12\x1e.ether8021x.Dot1xConfigRequest\x1a\x1f.ether8021x.Dot1xConfigResponse\x12F\n" +
 	"\tGetStatus\x12\x1c.ether8021x.InterfaceRequest\x1a\x1b.ether8021x.InterfaceStatus\x12K\n" +
 	"\fStreamStatus\x12\x1c.ether8021x.InterfaceRequest\x1a\x1b.ether8021x.InterfaceStatus0\x01\x12J\n" +
 	"\n" +
-	"Disconnect\x12\x1c.ether8021x.InterfaceRequest\x1a\x1e.ether8021x.DisconnectResponseB(Z&github.com/gavmckee80/dot1x-grpc/protob\x06proto3"
+	"Disconnect\x12\x1c.ether8021x.InterfaceRequest\x1a\x1e.ether8021x.DisconnectResponse\x12G\n" +
+	"\x0fStreamEapEvents\x12\x1c.ether8021x.InterfaceRequest\x1a\x14.ether8021x.EapEvent0\x01\x12J\n" +
+	"\x0fStreamAllStatus\x12\x18.ether8021x.StatusFilter\x1a\x1b.ether8021x.InterfaceStatus0\x01\x12Z\n" +
+	"\x11ConfigureAndWatch\x12$.ether8021x.ConfigureAndWatchRequest\x1a\x1d.ether8021x.ConfigureProgress0\x01\x12]\n" +
+	"\x10ListCertificates\x12#.ether8021x.ListCertificatesRequest\x1a$.ether8021x.ListCertificatesResponse\x12E\n" +
+	"\bListPacs\x12\x1b.ether8021x.ListPacsRequest\x1a\x1c.ether8021x.ListPacsResponse\x12H\n" +
+	"\tClearPacs\x12\x1c.ether8021x.ClearPacsRequest\x1a\x1d.ether8021x.ClearPacsResponse\x12W\n" +
+	"\x0eListEapMethods\x12!.ether8021x.ListEapMethodsRequest\x1a\".ether8021x.ListEapMethodsResponse\x12R\n" +
+	"\x0eReauthenticate\x12\x1c.ether8021x.InterfaceRequest\x1a\".ether8021x.ReauthenticateResponse\x12L\n" +
+	"\vEapolLogoff\x12\x1c.ether8021x.InterfaceRequest\x1a\x1f.ether8021x.EapolLogoffResponse\x12J\n" +
+	"\n" +
+	"EapolLogon\x12\x1c.ether8021x.InterfaceRequest\x1a\x1e.ether8021x.EapolLogonResponseB(Z&github.com/gavmckee80/dot1x-grpc/protob\x06proto3"
 
 var (
 	file_proto_ether8021x_proto_rawDescOnce sync.Once
@@ -477,31 +3274,117 @@ func file_proto_ether8021x_proto_rawDescGZIP() []byte {
 	return file_proto_ether8021x_proto_rawDescData
 }
 
-var file_proto_ether8021x_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_ether8021x_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_ether8021x_proto_enumTypes = make([]protoimpl.EnumInfo, 13)
+var file_proto_ether8021x_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_proto_ether8021x_proto_goTypes = []any{
-	(EapType)(0),                // 0: ether8021x.EapType
-	(*Dot1XConfigRequest)(nil),  // 1: ether8021x.Dot1xConfigRequest
-	(*Dot1XConfigResponse)(nil), // 2: ether8021x.Dot1xConfigResponse
-	(*InterfaceRequest)(nil),    // 3: ether8021x.InterfaceRequest
-	(*InterfaceStatus)(nil),     // 4: ether8021x.InterfaceStatus
-	(*DisconnectResponse)(nil),  // 5: ether8021x.DisconnectResponse
+	(KeyManagement)(0),               // 0: ether8021x.KeyManagement
+	(PmfMode)(0),                     // 1: ether8021x.PmfMode
+	(Phase2Method)(0),                // 2: ether8021x.Phase2Method
+	(MacsecMode)(0),                  // 3: ether8021x.MacsecMode
+	(PeapVersion)(0),                 // 4: ether8021x.PeapVersion
+	(TlsVersion)(0),                  // 5: ether8021x.TlsVersion
+	(FastProvisioning)(0),            // 6: ether8021x.FastProvisioning
+	(EapType)(0),                     // 7: ether8021x.EapType
+	(ConfigureStage)(0),              // 8: ether8021x.ConfigureStage
+	(SupplicantState)(0),             // 9: ether8021x.SupplicantState
+	(EapState)(0),                    // 10: ether8021x.EapState
+	(EapEventType)(0),                // 11: ether8021x.EapEventType
+	(CertificateRole)(0),             // 12: ether8021x.CertificateRole
+	(*Dot1XConfigRequest)(nil),       // 13: ether8021x.Dot1xConfigRequest
+	(*WirelessConfig)(nil),           // 14: ether8021x.WirelessConfig
+	(*InterfaceOptions)(nil),         // 15: ether8021x.InterfaceOptions
+	(*MacsecConfig)(nil),             // 16: ether8021x.MacsecConfig
+	(*Phase1Options)(nil),            // 17: ether8021x.Phase1Options
+	(*Dot1XConfigResponse)(nil),      // 18: ether8021x.Dot1xConfigResponse
+	(*FieldError)(nil),               // 19: ether8021x.FieldError
+	(*ConfigureAndWatchRequest)(nil), // 20: ether8021x.ConfigureAndWatchRequest
+	(*ConfigureProgress)(nil),        // 21: ether8021x.ConfigureProgress
+	(*InterfaceRequest)(nil),         // 22: ether8021x.InterfaceRequest
+	(*StatusFilter)(nil),             // 23: ether8021x.StatusFilter
+	(*InterfaceStatus)(nil),          // 24: ether8021x.InterfaceStatus
+	(*WirelessStatus)(nil),           // 25: ether8021x.WirelessStatus
+	(*CredentialChainStatus)(nil),    // 26: ether8021x.CredentialChainStatus
+	(*MacsecStatus)(nil),             // 27: ether8021x.MacsecStatus
+	(*DisconnectResponse)(nil),       // 28: ether8021x.DisconnectResponse
+	(*ReauthenticateResponse)(nil),   // 29: ether8021x.ReauthenticateResponse
+	(*EapolLogoffResponse)(nil),      // 30: ether8021x.EapolLogoffResponse
+	(*EapolLogonResponse)(nil),       // 31: ether8021x.EapolLogonResponse
+	(*EapEvent)(nil),                 // 32: ether8021x.EapEvent
+	(*ListCertificatesRequest)(nil),  // 33: ether8021x.ListCertificatesRequest
+	(*ListCertificatesResponse)(nil), // 34: ether8021x.ListCertificatesResponse
+	(*CertificateInfo)(nil),          // 35: ether8021x.CertificateInfo
+	(*ListPacsRequest)(nil),          // 36: ether8021x.ListPacsRequest
+	(*ListPacsResponse)(nil),         // 37: ether8021x.ListPacsResponse
+	(*PacInfo)(nil),                  // 38: ether8021x.PacInfo
+	(*ClearPacsRequest)(nil),         // 39: ether8021x.ClearPacsRequest
+	(*ClearPacsResponse)(nil),        // 40: ether8021x.ClearPacsResponse
+	(*ListEapMethodsRequest)(nil),    // 41: ether8021x.ListEapMethodsRequest
+	(*ListEapMethodsResponse)(nil),   // 42: ether8021x.ListEapMethodsResponse
 }
 var file_proto_ether8021x_proto_depIdxs = []int32{
-	0, // 0: ether8021x.Dot1xConfigRequest.eap_type:type_name -> ether8021x.EapType
-	1, // 1: ether8021x.Dot1xManager.ConfigureInterface:input_type -> ether8021x.Dot1xConfigRequest
-	3, // 2: ether8021x.Dot1xManager.GetStatus:input_type -> ether8021x.InterfaceRequest
-	3, // 3: ether8021x.Dot1xManager.StreamStatus:input_type -> ether8021x.InterfaceRequest
-	3, // 4: ether8021x.Dot1xManager.Disconnect:input_type -> ether8021x.InterfaceRequest
-	2, // 5: ether8021x.Dot1xManager.ConfigureInterface:output_type -> ether8021x.Dot1xConfigResponse
-	4, // 6: ether8021x.Dot1xManager.GetStatus:output_type -> ether8021x.InterfaceStatus
-	4, // 7: ether8021x.Dot1xManager.StreamStatus:output_type -> ether8021x.InterfaceStatus
-	5, // 8: ether8021x.Dot1xManager.Disconnect:output_type -> ether8021x.DisconnectResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7,  // 0: ether8021x.Dot1xConfigRequest.eap_type:type_name -> ether8021x.EapType
+	6,  // 1: ether8021x.Dot1xConfigRequest.fast_provisioning:type_name -> ether8021x.FastProvisioning
+	17, // 2: ether8021x.Dot1xConfigRequest.phase1:type_name -> ether8021x.Phase1Options
+	2,  // 3: ether8021x.Dot1xConfigRequest.phase2:type_name -> ether8021x.Phase2Method
+	16, // 4: ether8021x.Dot1xConfigRequest.macsec:type_name -> ether8021x.MacsecConfig
+	15, // 5: ether8021x.Dot1xConfigRequest.interface_options:type_name -> ether8021x.InterfaceOptions
+	14, // 6: ether8021x.Dot1xConfigRequest.wireless:type_name -> ether8021x.WirelessConfig
+	13, // 7: ether8021x.Dot1xConfigRequest.fallbacks:type_name -> ether8021x.Dot1xConfigRequest
+	0,  // 8: ether8021x.WirelessConfig.key_mgmt:type_name -> ether8021x.KeyManagement
+	1,  // 9: ether8021x.WirelessConfig.pmf:type_name -> ether8021x.PmfMode
+	3,  // 10: ether8021x.MacsecConfig.mode:type_name -> ether8021x.MacsecMode
+	4,  // 11: ether8021x.Phase1Options.peap_version:type_name -> ether8021x.PeapVersion
+	5,  // 12: ether8021x.Phase1Options.tls_min_version:type_name -> ether8021x.TlsVersion
+	5,  // 13: ether8021x.Phase1Options.tls_max_version:type_name -> ether8021x.TlsVersion
+	19, // 14: ether8021x.Dot1xConfigResponse.field_errors:type_name -> ether8021x.FieldError
+	13, // 15: ether8021x.ConfigureAndWatchRequest.config:type_name -> ether8021x.Dot1xConfigRequest
+	8,  // 16: ether8021x.ConfigureProgress.stage:type_name -> ether8021x.ConfigureStage
+	9,  // 17: ether8021x.InterfaceStatus.supplicant_state:type_name -> ether8021x.SupplicantState
+	10, // 18: ether8021x.InterfaceStatus.eap_status:type_name -> ether8021x.EapState
+	7,  // 19: ether8021x.InterfaceStatus.eap_type:type_name -> ether8021x.EapType
+	27, // 20: ether8021x.InterfaceStatus.macsec:type_name -> ether8021x.MacsecStatus
+	15, // 21: ether8021x.InterfaceStatus.interface_options:type_name -> ether8021x.InterfaceOptions
+	25, // 22: ether8021x.InterfaceStatus.wireless:type_name -> ether8021x.WirelessStatus
+	26, // 23: ether8021x.InterfaceStatus.credential_chain:type_name -> ether8021x.CredentialChainStatus
+	3,  // 24: ether8021x.MacsecStatus.mode:type_name -> ether8021x.MacsecMode
+	11, // 25: ether8021x.EapEvent.type:type_name -> ether8021x.EapEventType
+	35, // 26: ether8021x.ListCertificatesResponse.certificates:type_name -> ether8021x.CertificateInfo
+	12, // 27: ether8021x.CertificateInfo.role:type_name -> ether8021x.CertificateRole
+	38, // 28: ether8021x.ListPacsResponse.pacs:type_name -> ether8021x.PacInfo
+	7,  // 29: ether8021x.ListEapMethodsResponse.supported:type_name -> ether8021x.EapType
+	13, // 30: ether8021x.Dot1xManager.ConfigureInterface:input_type -> ether8021x.Dot1xConfigRequest
+	22, // 31: ether8021x.Dot1xManager.GetStatus:input_type -> ether8021x.InterfaceRequest
+	22, // 32: ether8021x.Dot1xManager.StreamStatus:input_type -> ether8021x.InterfaceRequest
+	22, // 33: ether8021x.Dot1xManager.Disconnect:input_type -> ether8021x.InterfaceRequest
+	22, // 34: ether8021x.Dot1xManager.StreamEapEvents:input_type -> ether8021x.InterfaceRequest
+	23, // 35: ether8021x.Dot1xManager.StreamAllStatus:input_type -> ether8021x.StatusFilter
+	20, // 36: ether8021x.Dot1xManager.ConfigureAndWatch:input_type -> ether8021x.ConfigureAndWatchRequest
+	33, // 37: ether8021x.Dot1xManager.ListCertificates:input_type -> ether8021x.ListCertificatesRequest
+	36, // 38: ether8021x.Dot1xManager.ListPacs:input_type -> ether8021x.ListPacsRequest
+	39, // 39: ether8021x.Dot1xManager.ClearPacs:input_type -> ether8021x.ClearPacsRequest
+	41, // 40: ether8021x.Dot1xManager.ListEapMethods:input_type -> ether8021x.ListEapMethodsRequest
+	22, // 41: ether8021x.Dot1xManager.Reauthenticate:input_type -> ether8021x.InterfaceRequest
+	22, // 42: ether8021x.Dot1xManager.EapolLogoff:input_type -> ether8021x.InterfaceRequest
+	22, // 43: ether8021x.Dot1xManager.EapolLogon:input_type -> ether8021x.InterfaceRequest
+	18, // 44: ether8021x.Dot1xManager.ConfigureInterface:output_type -> ether8021x.Dot1xConfigResponse
+	24, // 45: ether8021x.Dot1xManager.GetStatus:output_type -> ether8021x.InterfaceStatus
+	24, // 46: ether8021x.Dot1xManager.StreamStatus:output_type -> ether8021x.InterfaceStatus
+	28, // 47: ether8021x.Dot1xManager.Disconnect:output_type -> ether8021x.DisconnectResponse
+	32, // 48: ether8021x.Dot1xManager.StreamEapEvents:output_type -> ether8021x.EapEvent
+	24, // 49: ether8021x.Dot1xManager.StreamAllStatus:output_type -> ether8021x.InterfaceStatus
+	21, // 50: ether8021x.Dot1xManager.ConfigureAndWatch:output_type -> ether8021x.ConfigureProgress
+	34, // 51: ether8021x.Dot1xManager.ListCertificates:output_type -> ether8021x.ListCertificatesResponse
+	37, // 52: ether8021x.Dot1xManager.ListPacs:output_type -> ether8021x.ListPacsResponse
+	40, // 53: ether8021x.Dot1xManager.ClearPacs:output_type -> ether8021x.ClearPacsResponse
+	42, // 54: ether8021x.Dot1xManager.ListEapMethods:output_type -> ether8021x.ListEapMethodsResponse
+	29, // 55: ether8021x.Dot1xManager.Reauthenticate:output_type -> ether8021x.ReauthenticateResponse
+	30, // 56: ether8021x.Dot1xManager.EapolLogoff:output_type -> ether8021x.EapolLogoffResponse
+	31, // 57: ether8021x.Dot1xManager.EapolLogon:output_type -> ether8021x.EapolLogonResponse
+	44, // [44:58] is the sub-list for method output_type
+	30, // [30:44] is the sub-list for method input_type
+	30, // [30:30] is the sub-list for extension type_name
+	30, // [30:30] is the sub-list for extension extendee
+	0,  // [0:30] is the sub-list for field type_name
 }
 
 func init() { file_proto_ether8021x_proto_init() }
@@ -509,13 +3392,15 @@ func file_proto_ether8021x_proto_init() {
 	if File_proto_ether8021x_proto != nil {
 		return
 	}
+	file_proto_ether8021x_proto_msgTypes[2].OneofWrappers = []any{}
+	file_proto_ether8021x_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_ether8021x_proto_rawDesc), len(file_proto_ether8021x_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   5,
+			NumEnums:      13,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
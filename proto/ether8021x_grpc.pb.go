@@ -23,6 +23,16 @@ const (
 	Dot1XManager_GetStatus_FullMethodName          = "/ether8021x.Dot1xManager/GetStatus"
 	Dot1XManager_StreamStatus_FullMethodName       = "/ether8021x.Dot1xManager/StreamStatus"
 	Dot1XManager_Disconnect_FullMethodName         = "/ether8021x.Dot1xManager/Disconnect"
+	Dot1XManager_StreamEapEvents_FullMethodName    = "/ether8021x.Dot1xManager/StreamEapEvents"
+	Dot1XManager_StreamAllStatus_FullMethodName    = "/ether8021x.Dot1xManager/StreamAllStatus"
+	Dot1XManager_ConfigureAndWatch_FullMethodName  = "/ether8021x.Dot1xManager/ConfigureAndWatch"
+	Dot1XManager_ListCertificates_FullMethodName   = "/ether8021x.Dot1xManager/ListCertificates"
+	Dot1XManager_ListPacs_FullMethodName           = "/ether8021x.Dot1xManager/ListPacs"
+	Dot1XManager_ClearPacs_FullMethodName          = "/ether8021x.Dot1xManager/ClearPacs"
+	Dot1XManager_ListEapMethods_FullMethodName     = "/ether8021x.Dot1xManager/ListEapMethods"
+	Dot1XManager_Reauthenticate_FullMethodName     = "/ether8021x.Dot1xManager/Reauthenticate"
+	Dot1XManager_EapolLogoff_FullMethodName        = "/ether8021x.Dot1xManager/EapolLogoff"
+	Dot1XManager_EapolLogon_FullMethodName         = "/ether8021x.Dot1xManager/EapolLogon"
 )
 
 // Dot1XManagerClient is the client API for Dot1XManager service.
@@ -33,6 +43,16 @@ type Dot1XManagerClient interface {
 	GetStatus(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*InterfaceStatus, error)
 	StreamStatus(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceStatus], error)
 	Disconnect(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*DisconnectResponse, error)
+	StreamEapEvents(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EapEvent], error)
+	StreamAllStatus(ctx context.Context, in *StatusFilter, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceStatus], error)
+	ConfigureAndWatch(ctx context.Context, in *ConfigureAndWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConfigureProgress], error)
+	ListCertificates(ctx context.Context, in *ListCertificatesRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error)
+	ListPacs(ctx context.Context, in *ListPacsRequest, opts ...grpc.CallOption) (*ListPacsResponse, error)
+	ClearPacs(ctx context.Context, in *ClearPacsRequest, opts ...grpc.CallOption) (*ClearPacsResponse, error)
+	ListEapMethods(ctx context.Context, in *ListEapMethodsRequest, opts ...grpc.CallOption) (*ListEapMethodsResponse, error)
+	Reauthenticate(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*ReauthenticateResponse, error)
+	EapolLogoff(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*EapolLogoffResponse, error)
+	EapolLogon(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*EapolLogonResponse, error)
 }
 
 type dot1XManagerClient struct {
@@ -92,6 +112,133 @@ func (c *dot1XManagerClient) Disconnect(ctx context.Context, in *InterfaceReques
 	return out, nil
 }
 
+func (c *dot1XManagerClient) StreamEapEvents(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[EapEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dot1XManager_ServiceDesc.Streams[1], Dot1XManager_StreamEapEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[InterfaceRequest, EapEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dot1XManager_StreamEapEventsClient = grpc.ServerStreamingClient[EapEvent]
+
+func (c *dot1XManagerClient) StreamAllStatus(ctx context.Context, in *StatusFilter, opts ...grpc.CallOption) (grpc.ServerStreamingClient[InterfaceStatus], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dot1XManager_ServiceDesc.Streams[2], Dot1XManager_StreamAllStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StatusFilter, InterfaceStatus]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dot1XManager_StreamAllStatusClient = grpc.ServerStreamingClient[InterfaceStatus]
+
+func (c *dot1XManagerClient) ConfigureAndWatch(ctx context.Context, in *ConfigureAndWatchRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ConfigureProgress], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Dot1XManager_ServiceDesc.Streams[3], Dot1XManager_ConfigureAndWatch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ConfigureAndWatchRequest, ConfigureProgress]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dot1XManager_ConfigureAndWatchClient = grpc.ServerStreamingClient[ConfigureProgress]
+
+func (c *dot1XManagerClient) ListCertificates(ctx context.Context, in *ListCertificatesRequest, opts ...grpc.CallOption) (*ListCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCertificatesResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_ListCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dot1XManagerClient) ListPacs(ctx context.Context, in *ListPacsRequest, opts ...grpc.CallOption) (*ListPacsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPacsResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_ListPacs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dot1XManagerClient) ClearPacs(ctx context.Context, in *ClearPacsRequest, opts ...grpc.CallOption) (*ClearPacsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearPacsResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_ClearPacs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dot1XManagerClient) ListEapMethods(ctx context.Context, in *ListEapMethodsRequest, opts ...grpc.CallOption) (*ListEapMethodsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEapMethodsResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_ListEapMethods_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dot1XManagerClient) Reauthenticate(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*ReauthenticateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReauthenticateResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_Reauthenticate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dot1XManagerClient) EapolLogoff(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*EapolLogoffResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EapolLogoffResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_EapolLogoff_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dot1XManagerClient) EapolLogon(ctx context.Context, in *InterfaceRequest, opts ...grpc.CallOption) (*EapolLogonResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EapolLogonResponse)
+	err := c.cc.Invoke(ctx, Dot1XManager_EapolLogon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Dot1XManagerServer is the server API for Dot1XManager service.
 // All implementations must embed UnimplementedDot1XManagerServer
 // for forward compatibility.
@@ -100,6 +247,16 @@ type Dot1XManagerServer interface {
 	GetStatus(context.Context, *InterfaceRequest) (*InterfaceStatus, error)
 	StreamStatus(*InterfaceRequest, grpc.ServerStreamingServer[InterfaceStatus]) error
 	Disconnect(context.Context, *InterfaceRequest) (*DisconnectResponse, error)
+	StreamEapEvents(*InterfaceRequest, grpc.ServerStreamingServer[EapEvent]) error
+	StreamAllStatus(*StatusFilter, grpc.ServerStreamingServer[InterfaceStatus]) error
+	ConfigureAndWatch(*ConfigureAndWatchRequest, grpc.ServerStreamingServer[ConfigureProgress]) error
+	ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error)
+	ListPacs(context.Context, *ListPacsRequest) (*ListPacsResponse, error)
+	ClearPacs(context.Context, *ClearPacsRequest) (*ClearPacsResponse, error)
+	ListEapMethods(context.Context, *ListEapMethodsRequest) (*ListEapMethodsResponse, error)
+	Reauthenticate(context.Context, *InterfaceRequest) (*ReauthenticateResponse, error)
+	EapolLogoff(context.Context, *InterfaceRequest) (*EapolLogoffResponse, error)
+	EapolLogon(context.Context, *InterfaceRequest) (*EapolLogonResponse, error)
 	mustEmbedUnimplementedDot1XManagerServer()
 }
 
@@ -122,6 +279,36 @@ func (UnimplementedDot1XManagerServer) StreamStatus(*InterfaceRequest, grpc.Serv
 func (UnimplementedDot1XManagerServer) Disconnect(context.Context, *InterfaceRequest) (*DisconnectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
 }
+func (UnimplementedDot1XManagerServer) StreamEapEvents(*InterfaceRequest, grpc.ServerStreamingServer[EapEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEapEvents not implemented")
+}
+func (UnimplementedDot1XManagerServer) StreamAllStatus(*StatusFilter, grpc.ServerStreamingServer[InterfaceStatus]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamAllStatus not implemented")
+}
+func (UnimplementedDot1XManagerServer) ConfigureAndWatch(*ConfigureAndWatchRequest, grpc.ServerStreamingServer[ConfigureProgress]) error {
+	return status.Errorf(codes.Unimplemented, "method ConfigureAndWatch not implemented")
+}
+func (UnimplementedDot1XManagerServer) ListCertificates(context.Context, *ListCertificatesRequest) (*ListCertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCertificates not implemented")
+}
+func (UnimplementedDot1XManagerServer) ListPacs(context.Context, *ListPacsRequest) (*ListPacsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPacs not implemented")
+}
+func (UnimplementedDot1XManagerServer) ClearPacs(context.Context, *ClearPacsRequest) (*ClearPacsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearPacs not implemented")
+}
+func (UnimplementedDot1XManagerServer) ListEapMethods(context.Context, *ListEapMethodsRequest) (*ListEapMethodsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEapMethods not implemented")
+}
+func (UnimplementedDot1XManagerServer) Reauthenticate(context.Context, *InterfaceRequest) (*ReauthenticateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reauthenticate not implemented")
+}
+func (UnimplementedDot1XManagerServer) EapolLogoff(context.Context, *InterfaceRequest) (*EapolLogoffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EapolLogoff not implemented")
+}
+func (UnimplementedDot1XManagerServer) EapolLogon(context.Context, *InterfaceRequest) (*EapolLogonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EapolLogon not implemented")
+}
 func (UnimplementedDot1XManagerServer) mustEmbedUnimplementedDot1XManagerServer() {}
 func (UnimplementedDot1XManagerServer) testEmbeddedByValue()                      {}
 
@@ -208,6 +395,165 @@ func _Dot1XManager_Disconnect_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dot1XManager_StreamEapEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(InterfaceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Dot1XManagerServer).StreamEapEvents(m, &grpc.GenericServerStream[InterfaceRequest, EapEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dot1XManager_StreamEapEventsServer = grpc.ServerStreamingServer[EapEvent]
+
+func _Dot1XManager_StreamAllStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StatusFilter)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Dot1XManagerServer).StreamAllStatus(m, &grpc.GenericServerStream[StatusFilter, InterfaceStatus]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dot1XManager_StreamAllStatusServer = grpc.ServerStreamingServer[InterfaceStatus]
+
+func _Dot1XManager_ConfigureAndWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ConfigureAndWatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(Dot1XManagerServer).ConfigureAndWatch(m, &grpc.GenericServerStream[ConfigureAndWatchRequest, ConfigureProgress]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Dot1XManager_ConfigureAndWatchServer = grpc.ServerStreamingServer[ConfigureProgress]
+
+func _Dot1XManager_ListCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).ListCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_ListCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).ListCertificates(ctx, req.(*ListCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dot1XManager_ListPacs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPacsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).ListPacs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_ListPacs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).ListPacs(ctx, req.(*ListPacsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dot1XManager_ClearPacs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearPacsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).ClearPacs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_ClearPacs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).ClearPacs(ctx, req.(*ClearPacsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dot1XManager_ListEapMethods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEapMethodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).ListEapMethods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_ListEapMethods_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).ListEapMethods(ctx, req.(*ListEapMethodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dot1XManager_Reauthenticate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterfaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).Reauthenticate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_Reauthenticate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).Reauthenticate(ctx, req.(*InterfaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dot1XManager_EapolLogoff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterfaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).EapolLogoff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_EapolLogoff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).EapolLogoff(ctx, req.(*InterfaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dot1XManager_EapolLogon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InterfaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(Dot1XManagerServer).EapolLogon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dot1XManager_EapolLogon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(Dot1XManagerServer).EapolLogon(ctx, req.(*InterfaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Dot1XManager_ServiceDesc is the grpc.ServiceDesc for Dot1XManager service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +573,34 @@ var Dot1XManager_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Disconnect",
 			Handler:    _Dot1XManager_Disconnect_Handler,
 		},
+		{
+			MethodName: "ListCertificates",
+			Handler:    _Dot1XManager_ListCertificates_Handler,
+		},
+		{
+			MethodName: "ListPacs",
+			Handler:    _Dot1XManager_ListPacs_Handler,
+		},
+		{
+			MethodName: "ClearPacs",
+			Handler:    _Dot1XManager_ClearPacs_Handler,
+		},
+		{
+			MethodName: "ListEapMethods",
+			Handler:    _Dot1XManager_ListEapMethods_Handler,
+		},
+		{
+			MethodName: "Reauthenticate",
+			Handler:    _Dot1XManager_Reauthenticate_Handler,
+		},
+		{
+			MethodName: "EapolLogoff",
+			Handler:    _Dot1XManager_EapolLogoff_Handler,
+		},
+		{
+			MethodName: "EapolLogon",
+			Handler:    _Dot1XManager_EapolLogon_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -234,6 +608,21 @@ var Dot1XManager_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Dot1XManager_StreamStatus_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "StreamEapEvents",
+			Handler:       _Dot1XManager_StreamEapEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamAllStatus",
+			Handler:       _Dot1XManager_StreamAllStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ConfigureAndWatch",
+			Handler:       _Dot1XManager_ConfigureAndWatch_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/ether8021x.proto",
 }
@@ -0,0 +1,159 @@
+package test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"io"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	grpcapi "github.com/gavmckee80/dot1x-grpc/internal/grpc"
+	"github.com/gavmckee80/dot1x-grpc/internal/metrics"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// configureExpiringPorts configures eth1 with a client certificate valid for a day
+// and eth2 with one expiring within the hour, both issued by the same CA.
+func configureExpiringPorts(t *testing.T, configure func(*pb.Dot1XConfigRequest) (*pb.Dot1XConfigResponse, error)) {
+	t.Helper()
+	pki := newTestPKI(t)
+	shortLived := pki.issue(t, pki.key, func(c *x509.Certificate) {
+		c.Subject.CommonName = "short-lived"
+		c.NotAfter = time.Now().Add(time.Hour)
+	})
+	for iface, cert := range map[string][]byte{"eth1": pki.Cert, "eth2": shortLived} {
+		resp, err := configure(&pb.Dot1XConfigRequest{
+			Interface:  iface,
+			EapType:    pb.EapType_EAP_TLS,
+			Identity:   "device",
+			CaCert:     pki.CA,
+			ClientCert: cert,
+			PrivateKey: pki.Key,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("Configure %s failed: %v %v", iface, err, resp)
+		}
+	}
+}
+
+func TestListCertificates(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	client := newIsolatedClient(t, &MockSupplicant{}, core.WithExpiryWarning(12*time.Hour))
+	configureExpiringPorts(t, func(req *pb.Dot1XConfigRequest) (*pb.Dot1XConfigResponse, error) {
+		return client.ConfigureInterface(ctx, req)
+	})
+
+	resp, err := client.ListCertificates(ctx, &pb.ListCertificatesRequest{})
+	if err != nil {
+		t.Fatalf("ListCertificates error: %v", err)
+	}
+	certs := resp.Certificates
+	if len(certs) != 3 {
+		t.Fatalf("Expected CA and two client certificates, got %v", certs)
+	}
+
+	// Ordered by expiry, so the short-lived certificate comes first
+	first := certs[0]
+	if first.Subject != "CN=short-lived" || first.Role != pb.CertificateRole_CERTIFICATE_ROLE_CLIENT ||
+		!first.Expiring || len(first.Interfaces) != 1 || first.Interfaces[0] != "eth2" {
+		t.Errorf("Unexpected first certificate: %v", first)
+	}
+	if first.Issuer != "CN=Test CA" || first.Serial == "" || len(first.FingerprintSha256) != 64 {
+		t.Errorf("Expected issuer, serial and fingerprint, got %v", first)
+	}
+	for _, c := range certs[1:] {
+		if c.Expiring {
+			t.Errorf("Expected %s not to be expiring", c.Subject)
+		}
+		if c.Role == pb.CertificateRole_CERTIFICATE_ROLE_CA && len(c.Interfaces) != 2 {
+			t.Errorf("Expected the shared CA to list both interfaces, got %v", c.Interfaces)
+		}
+	}
+
+	filtered, err := client.ListCertificates(ctx, &pb.ListCertificatesRequest{Interfaces: []string{"eth1"}})
+	if err != nil || len(filtered.Certificates) != 2 {
+		t.Errorf("Expected 2 certificates for eth1, got %v %v", filtered, err)
+	}
+
+	// Status streams report the expiring certificate after the snapshot
+	stream, err := client.StreamStatus(ctx, &pb.InterfaceRequest{Interface: "eth2"})
+	if err != nil {
+		t.Fatalf("StreamStatus error: %v", err)
+	}
+	if st, err := stream.Recv(); err != nil || st.LastEvent != "snapshot" {
+		t.Fatalf("Expected snapshot, got %v %v", st, err)
+	}
+	st, err := stream.Recv()
+	if err != nil || st.LastEvent != "certificate-expiring" || !strings.Contains(st.EventDetail, "CN=short-lived") {
+		t.Errorf("Expected certificate-expiring warning, got %v %v", st, err)
+	}
+}
+
+func TestCertificateMetrics(t *testing.T) {
+	manager := core.NewInterfaceManagerWithClient(&MockSupplicant{},
+		core.WithLinkInfoProvider(&MockLinkInfo{}), core.WithExpiryWarning(12*time.Hour), testCredentialDir(t))
+	configureExpiringPorts(t, manager.Configure)
+
+	rec := httptest.NewRecorder()
+	metrics.Handler(grpcapi.NewDot1xServiceWithManager(manager)).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+
+	for _, want := range []string{
+		`interface="eth2",role="client",serial=`,
+		`subject="CN=short-lived"} 1`,
+		`interface="eth1",role="ca",serial=`,
+		`dot1x_certificate_not_after_timestamp_seconds{fingerprint_sha256=`,
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("Expected metrics to contain %q", want)
+		}
+	}
+}
+
+func TestCertificateMetricsReissuedRoot(t *testing.T) {
+	manager := core.NewInterfaceManagerWithClient(&MockSupplicant{}, core.WithLinkInfoProvider(&MockLinkInfo{}), testCredentialDir(t))
+	pki := newTestPKI(t)
+
+	// A root re-issued with a new key keeps its subject and serial
+	key := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          pki.caCert.SerialNumber,
+		Subject:               pki.caCert.Subject,
+		NotBefore:             pki.caCert.NotBefore,
+		NotAfter:              pki.caCert.NotAfter.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	reissued, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("Failed to re-issue CA: %v", err)
+	}
+	resp, err := manager.Configure(&pb.Dot1XConfigRequest{
+		Interface:  "eth1",
+		EapType:    pb.EapType_EAP_TLS,
+		Identity:   "device",
+		CaCert:     append(slices.Clone(pki.CA), pemEncode("CERTIFICATE", reissued)...),
+		ClientCert: pki.Cert,
+		PrivateKey: pki.Key,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.Handler(grpcapi.NewDot1xServiceWithManager(manager)).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, _ := io.ReadAll(rec.Body)
+	if rec.Code != 200 {
+		t.Fatalf("Expected the scrape to succeed, got %d: %s", rec.Code, body)
+	}
+	if n := strings.Count(string(body), `dot1x_certificate_expiring{fingerprint_sha256=`); n != 3 {
+		t.Errorf("Expected a series per certificate, got %d", n)
+	}
+}
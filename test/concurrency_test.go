@@ -0,0 +1,140 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/credentials"
+	grpcapi "github.com/gavmckee80/dot1x-grpc/internal/grpc"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// testCredentialDir keeps the credential files of a test's manager in its own
+// temporary directory.
+func testCredentialDir(t *testing.T) core.Option {
+	t.Helper()
+	creds, err := credentials.OpenDir(t.TempDir())
+	if err != nil {
+		t.Fatalf("OpenDir error: %v", err)
+	}
+	return core.WithCredentialDir(creds)
+}
+
+// newIsolatedClient starts a dedicated bufconn server backed by the given mock so
+// that tests tuning the mock do not affect the shared server.
+func newIsolatedClient(t *testing.T, m *MockSupplicant, opts ...core.Option) pb.Dot1XManagerClient {
+	t.Helper()
+	l := bufconn.Listen(bufSize)
+	s := grpc.NewServer()
+	opts = append([]core.Option{core.WithLinkInfoProvider(&MockLinkInfo{}), testCredentialDir(t)}, opts...)
+	manager := core.NewInterfaceManagerWithClient(m, opts...)
+	pb.RegisterDot1XManagerServer(s, grpcapi.NewDot1xServiceWithManager(manager))
+	go s.Serve(l)
+
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return l.Dial() }),
+		grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	t.Cleanup(func() {
+		conn.Close()
+		s.Stop()
+	})
+	return pb.NewDot1XManagerClient(conn)
+}
+
+func TestConcurrentConfigureDisconnect(t *testing.T) {
+	m := &MockSupplicant{OpDelay: time.Millisecond}
+	client := newIsolatedClient(t, m)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	// Watch everything while the interfaces are hammered
+	stream, err := client.StreamAllStatus(ctx, &pb.StatusFilter{})
+	if err != nil {
+		t.Fatalf("StreamAllStatus error: %v", err)
+	}
+	go func() {
+		for {
+			if _, err := stream.Recv(); err != nil {
+				return
+			}
+		}
+	}()
+
+	const ports, rounds = 8, 5
+	var wg sync.WaitGroup
+	for p := 1; p <= ports; p++ {
+		iface := fmt.Sprintf("eth%d", p)
+		for w := 0; w < 3; w++ {
+			wg.Add(1)
+			go func(iface string, w int) {
+				defer wg.Done()
+				for r := 0; r < rounds; r++ {
+					switch (w + r) % 3 {
+					case 0:
+						resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+							Interface:  iface,
+							EapType:    pb.EapType_EAP_PEAP,
+							Identity:   "testuser",
+							Password:   "testpass",
+							Phase2Auth: "mschapv2",
+						})
+						if err != nil || !resp.Success {
+							t.Errorf("[%s] Configure failed: %v %v", iface, err, resp)
+						}
+					case 1:
+						if _, err := client.Disconnect(ctx, &pb.InterfaceRequest{Interface: iface}); err != nil {
+							t.Errorf("[%s] Disconnect failed: %v", iface, err)
+						}
+					case 2:
+						// NotFound is expected before the first Configure lands
+						client.GetStatus(ctx, &pb.InterfaceRequest{Interface: iface})
+					}
+				}
+			}(iface, w)
+		}
+	}
+	wg.Wait()
+
+	if n := m.Overlaps(); n != 0 {
+		t.Errorf("Expected operations on the same interface to be serialized, saw %d overlaps", n)
+	}
+}
+
+func TestConcurrentPortsProceedInParallel(t *testing.T) {
+	const ports = 8
+	m := &MockSupplicant{ConcurrentPorts: ports}
+	client := newIsolatedClient(t, m)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for p := 1; p <= ports; p++ {
+		wg.Add(1)
+		go func(iface string) {
+			defer wg.Done()
+			client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+				Interface:  iface,
+				EapType:    pb.EapType_EAP_PEAP,
+				Identity:   "testuser",
+				Password:   "testpass",
+				Phase2Auth: "mschapv2",
+			})
+		}(fmt.Sprintf("eth%d", p))
+	}
+	wg.Wait()
+
+	// Serialized ports would each finish before the next one adds its network
+	if peak := m.Peak(); peak != ports {
+		t.Errorf("Expected all %d ports to be configured at once, at most %d were", ports, peak)
+	}
+}
@@ -0,0 +1,116 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/credentials"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestCredentialDirectory(t *testing.T) {
+	ctx := context.Background()
+	root := filepath.Join(t.TempDir(), "credentials")
+
+	// Files left behind by a crashed run
+	orphan := filepath.Join(root, "eth1", "stale-key.pem")
+	if err := os.MkdirAll(filepath.Dir(orphan), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(orphan, []byte("STALE KEY"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := credentials.OpenDir(root)
+	if err != nil {
+		t.Fatalf("OpenDir error: %v", err)
+	}
+	if _, err := os.Stat(orphan); !os.IsNotExist(err) {
+		t.Errorf("Expected orphaned credential to be swept on open, stat: %v", err)
+	}
+	// Without blob support the credentials fall back to files
+	m := &MockSupplicant{NoBlobs: true}
+	client := newIsolatedClient(t, m, core.WithCredentialDir(dir))
+
+	if info, err := os.Stat(root); err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("Expected credential directory with mode 0700, got %v %v", info.Mode(), err)
+	}
+
+	pki := newTestPKI(t)
+	resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:  "eth1",
+		EapType:    pb.EapType_EAP_TLS,
+		Identity:   "device",
+		CaCert:     pki.CA,
+		ClientCert: pki.Cert,
+		PrivateKey: pki.Key,
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+
+	nets := m.Networks("eth1")
+	if len(nets) != 1 {
+		t.Fatalf("Expected 1 network, got %d", len(nets))
+	}
+	var files []string
+	for _, key := range []string{"ca_cert", "client_cert", "private_key"} {
+		path := nets[0][key]
+		if filepath.Dir(path) != filepath.Join(root, "eth1") {
+			t.Errorf("Expected %s in the eth1 credential directory, got %s", key, path)
+		}
+		if info, err := os.Stat(path); err != nil || info.Mode().Perm() != 0600 {
+			t.Errorf("Expected %s with mode 0600, got %v %v", key, info, err)
+		}
+		files = append(files, path)
+	}
+	if info, err := os.Stat(filepath.Join(root, "eth1")); err != nil || info.Mode().Perm() != 0700 {
+		t.Errorf("Expected interface directory with mode 0700, got %v %v", info, err)
+	}
+
+	if _, err := client.Disconnect(ctx, &pb.InterfaceRequest{Interface: "eth1"}); err != nil {
+		t.Fatalf("Disconnect error: %v", err)
+	}
+	for _, path := range files {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be removed on disconnect, stat: %v", path, err)
+		}
+	}
+	if len(m.Networks("eth1")) != 0 {
+		t.Errorf("Expected the network to be removed on disconnect")
+	}
+}
+
+func TestCredentialDirectoryRejectsPlantedDirectory(t *testing.T) {
+	// A symlink to a directory someone else prepared
+	target := t.TempDir()
+	link := filepath.Join(t.TempDir(), "credentials")
+	if err := os.Symlink(target, link); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := credentials.OpenDir(link); err == nil {
+		t.Errorf("Expected a symlinked credential directory to be rejected")
+	}
+
+	// A directory owned by another user, which root could otherwise chmod
+	if os.Geteuid() != 0 {
+		t.Skip("changing the owner of a directory requires root")
+	}
+	planted := filepath.Join(t.TempDir(), "credentials")
+	keep := filepath.Join(planted, "keep")
+	if err := os.MkdirAll(keep, 0777); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chown(planted, 65534, 65534); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := credentials.OpenDir(planted); err == nil {
+		t.Errorf("Expected a directory owned by another user to be rejected")
+	}
+	if _, err := os.Stat(keep); err != nil {
+		t.Errorf("Expected the planted directory not to be swept: %v", err)
+	}
+}
@@ -0,0 +1,299 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestCredentialFallback(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	pki := newTestPKI(t)
+	req := &pb.InterfaceRequest{Interface: "eth95"}
+
+	chained := func() *pb.Dot1XConfigRequest {
+		return &pb.Dot1XConfigRequest{
+			Interface:  "eth95",
+			EapType:    pb.EapType_EAP_TLS,
+			Identity:   "host.example.com",
+			CaCert:     pki.CA,
+			ClientCert: pki.Cert,
+			PrivateKey: pki.Key,
+			Fallbacks: []*pb.Dot1XConfigRequest{{
+				EapType:  pb.EapType_EAP_PEAP,
+				Identity: "svc-dot1x",
+				Password: "pw",
+				CaCert:   pki.CA,
+			}},
+		}
+	}
+	chainStatus := func() (*pb.InterfaceStatus, *pb.CredentialChainStatus) {
+		t.Helper()
+		st, err := client.GetStatus(ctx, req)
+		if err != nil {
+			t.Fatalf("GetStatus error: %v", err)
+		}
+		return st, st.CredentialChain
+	}
+
+	// The machine certificate is rejected; the service account takes over
+	m.FailMethod("eth95", "TLS", true)
+	resp, err := client.ConfigureInterface(ctx, chained())
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	nets := m.Networks("eth95")
+	if len(nets) != 2 {
+		t.Fatalf("Expected a network per credential set, got %d", len(nets))
+	}
+	for i, want := range []map[string]string{
+		{"eap": "TLS", "identity": "host.example.com", "priority": "2"},
+		{"eap": "PEAP", "identity": "svc-dot1x", "priority": "1", "phase2": "auth=MSCHAPV2"},
+	} {
+		for key, w := range want {
+			if got := nets[i][key]; got != w {
+				t.Errorf("Expected network %d %s=%q, got %q", i, key, w, got)
+			}
+		}
+	}
+
+	waitFor(t, "the fallback to authenticate", func() bool {
+		_, chain := chainStatus()
+		return chain.GetActive() == 1 && chain.GetAuthenticated()
+	})
+	st, chain := chainStatus()
+	if chain.Entries != 2 || !slices.Equal(chain.Failed, []uint32{0}) {
+		t.Errorf("Expected 2 entries with the first rejected, got %v", chain)
+	}
+	if st.EapType != pb.EapType_EAP_PEAP || st.Identity != "svc-dot1x" {
+		t.Errorf("Expected the status to report the fallback credentials, got %s %s", st.EapType, st.Identity)
+	}
+
+	// The certificates of every credential set are tracked
+	certs, err := client.ListCertificates(ctx, &pb.ListCertificatesRequest{Interfaces: []string{"eth95"}})
+	if err != nil {
+		t.Fatalf("ListCertificates error: %v", err)
+	}
+	for _, c := range certs.Certificates {
+		if len(c.Interfaces) != 1 {
+			t.Errorf("Expected %s to be listed once for eth95, got %v", c.Subject, c.Interfaces)
+		}
+	}
+	if len(certs.Certificates) != 2 {
+		t.Errorf("Expected the client and CA certificates, got %d", len(certs.Certificates))
+	}
+
+	// Once every set is rejected the interface stays on the last one
+	m.FailMethod("eth95", "PEAP", true)
+	resp, err = client.ConfigureInterface(ctx, chained())
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	waitFor(t, "every credential set to be rejected", func() bool {
+		_, chain := chainStatus()
+		return len(chain.GetFailed()) == 2
+	})
+	if _, chain := chainStatus(); chain.Active != 1 || chain.Authenticated {
+		t.Errorf("Expected the last set to stay selected and unauthenticated, got %v", chain)
+	}
+	if nets := m.Networks("eth95"); len(nets) != 2 {
+		t.Errorf("Expected the previous networks to be replaced, got %d networks", len(nets))
+	}
+
+	// A configuration without fallbacks has no chain
+	m.FailMethod("eth95", "TLS", false)
+	primary := chained()
+	primary.Fallbacks = nil
+	if resp, err := client.ConfigureInterface(ctx, primary); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if st, chain := chainStatus(); chain != nil || st.EapType != pb.EapType_EAP_TLS {
+		t.Errorf("Expected no credential chain, got %v", chain)
+	}
+	if nets := m.Networks("eth95"); len(nets) != 1 || nets[0]["priority"] != "" {
+		t.Errorf("Expected a single network without priority, got %v", nets)
+	}
+}
+
+func TestConfigureAndWatchFallback(t *testing.T) {
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	m.FailMethod("eth96", "TTLS", true)
+
+	stream, err := client.ConfigureAndWatch(context.Background(), &pb.ConfigureAndWatchRequest{Config: &pb.Dot1XConfigRequest{
+		Interface: "eth96",
+		EapType:   pb.EapType_EAP_TTLS,
+		Identity:  "alice",
+		Password:  "old",
+		Fallbacks: []*pb.Dot1XConfigRequest{{EapType: pb.EapType_EAP_PEAP, Identity: "alice", Password: "new"}},
+	}})
+	if err != nil {
+		t.Fatalf("ConfigureAndWatch error: %v", err)
+	}
+
+	var messages []string
+	for {
+		p, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Error reading progress: %v", err)
+		}
+		messages = append(messages, p.Message)
+		if p.Terminal {
+			if !p.Success || p.Stage != pb.ConfigureStage_CONFIGURE_STAGE_AUTHENTICATED {
+				t.Fatalf("Expected the fallback to authenticate, got %v after %v", p, messages)
+			}
+			break
+		}
+	}
+	if !slices.ContainsFunc(messages, func(msg string) bool { return strings.Contains(msg, "falling back to credential set 1") }) {
+		t.Errorf("Expected the fallback to be reported, got %v", messages)
+	}
+}
+
+func TestFallbackValidation(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	peap := func() *pb.Dot1XConfigRequest {
+		return &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PEAP, Identity: "svc", Password: "pw"}
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(req *pb.Dot1XConfigRequest)
+		fields []string
+	}{
+		{
+			name: "interface settings",
+			edit: func(req *pb.Dot1XConfigRequest) {
+				req.Fallbacks[0].Interface = "eth98"
+				req.Fallbacks[0].Wireless = &pb.WirelessConfig{Ssid: "corp"}
+			},
+			fields: []string{"fallbacks[0].interface", "fallbacks[0].wireless"},
+		},
+		{
+			name:   "nested fallbacks",
+			edit:   func(req *pb.Dot1XConfigRequest) { req.Fallbacks[0].Fallbacks = []*pb.Dot1XConfigRequest{peap()} },
+			fields: []string{"fallbacks[0].fallbacks"},
+		},
+		{
+			name: "missing password",
+			edit: func(req *pb.Dot1XConfigRequest) {
+				req.Fallbacks = append(req.Fallbacks, &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PWD, Identity: "svc"})
+			},
+			fields: []string{"fallbacks[1].password"},
+		},
+		{
+			name: "pre-shared MACsec key",
+			edit: func(req *pb.Dot1XConfigRequest) {
+				req.EapType, req.Identity, req.Password = pb.EapType_EAP_UNKNOWN, "", ""
+				req.Macsec = &pb.MacsecConfig{Mode: pb.MacsecMode_MACSEC_MODE_PSK, MkaCak: bytes.Repeat([]byte{1}, 16), MkaCkn: []byte{1}}
+			},
+			fields: []string{"fallbacks"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.Dot1XConfigRequest{
+				Interface: "eth97",
+				EapType:   pb.EapType_EAP_TTLS,
+				Identity:  "alice",
+				Password:  "pw",
+				Fallbacks: []*pb.Dot1XConfigRequest{peap()},
+			}
+			tt.edit(req)
+			resp, err := client.ConfigureInterface(ctx, req)
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+			if resp.Success || len(resp.FieldErrors) != len(tt.fields) {
+				t.Fatalf("Expected errors on %v, got %v", tt.fields, resp)
+			}
+			for i, fe := range resp.FieldErrors {
+				if fe.Field != tt.fields[i] {
+					t.Errorf("Expected error on %s, got %s: %s", tt.fields[i], fe.Field, fe.Reason)
+				}
+			}
+		})
+	}
+
+	// An inner method the fallback cannot run is an invalid argument against its field
+	fallback := peap()
+	fallback.Phase2 = pb.Phase2Method_PHASE2_PAP
+	_, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth97",
+		EapType:   pb.EapType_EAP_TTLS,
+		Identity:  "alice",
+		Password:  "pw",
+		Fallbacks: []*pb.Dot1XConfigRequest{fallback},
+	})
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("Expected InvalidArgument, got %v", err)
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			violations = br.FieldViolations
+		}
+	}
+	if len(violations) != 1 || violations[0].Field != "fallbacks[0].phase2" {
+		t.Errorf("Expected a violation of fallbacks[0].phase2, got %v", violations)
+	}
+	if nets := m.Networks("eth97"); len(nets) != 0 {
+		t.Errorf("Expected no network for invalid requests, got %v", nets)
+	}
+}
+
+func TestShutdownStopsFailover(t *testing.T) {
+	chained := &pb.Dot1XConfigRequest{
+		Interface: "eth93",
+		EapType:   pb.EapType_EAP_TTLS,
+		Identity:  "alice",
+		Password:  "pw",
+		Fallbacks: []*pb.Dot1XConfigRequest{{EapType: pb.EapType_EAP_PEAP, Identity: "alice", Password: "pw"}},
+	}
+	configured := func(m *MockSupplicant) *core.InterfaceManager {
+		t.Helper()
+		manager := core.NewInterfaceManagerWithClient(m, core.WithLinkInfoProvider(&MockLinkInfo{}), testCredentialDir(t))
+		if resp, err := manager.Configure(chained); err != nil || !resp.Success {
+			t.Fatalf("Configure failed: %v %v", err, resp)
+		}
+		return manager
+	}
+
+	m := &MockSupplicant{}
+	manager := configured(m)
+	if m.EAPSubscribers("eth93") == 0 {
+		t.Fatal("Expected the failover to watch EAP events")
+	}
+	manager.Shutdown()
+	if n := m.EAPSubscribers("eth93"); n != 0 {
+		t.Errorf("Expected the failover watch to end on shutdown, got %d subscriptions", n)
+	}
+	if !slices.Contains(m.Removed, "/mock/eth93") {
+		t.Errorf("Expected eth93 to be removed, got %v", m.Removed)
+	}
+
+	// An interface that went away with wpa_supplicant has no object left to remove
+	m = &MockSupplicant{}
+	manager = configured(m)
+	m.Stop()
+	waitFor(t, "eth93 to be invalidated", func() bool { return m.EAPSubscribers("eth93") == 0 })
+	manager.Shutdown()
+	if len(m.Removed) != 0 {
+		t.Errorf("Expected no interface to be removed, got %q", m.Removed)
+	}
+}
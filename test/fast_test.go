@@ -0,0 +1,142 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/pac"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// testPAC is a PAC file as written by wpa_supplicant after provisioning by a server
+// whose A-ID-Info is "ISE".
+const testPAC = `wpa_supplicant EAP-FAST PAC file - version 1
+START
+PAC-Type=1
+PAC-Key=000102030405060708090a0b0c0d0e0f101112131415161718191a1b1c1d1e1f
+PAC-Opaque=00020040aabbccdd
+A-ID=0123456789abcdef
+I-ID=646576696365
+I-ID-txt=device
+A-ID-Info=495345
+A-ID-Info-txt=ISE
+END
+`
+
+func TestConfigureFAST(t *testing.T) {
+	ctx := context.Background()
+	root := filepath.Join(t.TempDir(), "pac")
+	pacs, err := pac.OpenStore(root)
+	if err != nil {
+		t.Fatalf("OpenStore error: %v", err)
+	}
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m, core.WithPACDir(pacs))
+
+	fast := func(ifname string, edit func(*pb.Dot1XConfigRequest)) *pb.Dot1XConfigResponse {
+		t.Helper()
+		req := &pb.Dot1XConfigRequest{
+			Interface: ifname,
+			EapType:   pb.EapType_EAP_FAST,
+			Identity:  "device",
+			Password:  "secret",
+		}
+		edit(req)
+		resp, err := client.ConfigureInterface(ctx, req)
+		if err != nil {
+			t.Fatalf("ConfigureInterface error: %v", err)
+		}
+		return resp
+	}
+	expectFieldError := func(resp *pb.Dot1XConfigResponse, field string) {
+		t.Helper()
+		if resp.Success || len(resp.FieldErrors) != 1 || resp.FieldErrors[0].Field != field {
+			t.Errorf("Expected a single %s error, got %v", field, resp)
+		}
+	}
+
+	// Without a PAC, provisioning must be allowed
+	expectFieldError(fast("eth30", func(*pb.Dot1XConfigRequest) {}), "fast_provisioning")
+	expectFieldError(fast("eth30", func(r *pb.Dot1XConfigRequest) {
+		r.Password = ""
+		r.FastProvisioning = pb.FastProvisioning_FAST_PROVISIONING_BOTH
+	}), "password")
+
+	resp := fast("eth30", func(r *pb.Dot1XConfigRequest) {
+		r.FastProvisioning = pb.FastProvisioning_FAST_PROVISIONING_UNAUTHENTICATED
+	})
+	if !resp.Success {
+		t.Fatalf("Expected success, got %v", resp)
+	}
+	nets := m.Networks("eth30")
+	if len(nets) != 1 {
+		t.Fatalf("Expected 1 network on eth30, got %d", len(nets))
+	}
+	pacFile := filepath.Join(root, "eth30.pac")
+	for key, want := range map[string]string{
+		"eap":      "FAST",
+		"phase1":   "fast_provisioning=1",
+		"phase2":   "auth=MSCHAPV2",
+		"pac_file": pacFile,
+	} {
+		if got := nets[0][key]; got != want {
+			t.Errorf("Expected %s=%q, got %q", key, want, got)
+		}
+	}
+
+	// wpa_supplicant stores the PAC it is provisioned with
+	if err := os.WriteFile(pacFile, []byte(testPAC), 0600); err != nil {
+		t.Fatal(err)
+	}
+	list, err := client.ListPacs(ctx, &pb.ListPacsRequest{})
+	if err != nil {
+		t.Fatalf("ListPacs error: %v", err)
+	}
+	if len(list.Pacs) != 1 {
+		t.Fatalf("Expected 1 PAC, got %v", list.Pacs)
+	}
+	if p := list.Pacs[0]; p.Interface != "eth30" || p.PacType != 1 || p.AId != "0123456789abcdef" || p.AIdInfo != "ISE" || p.IId != "646576696365" {
+		t.Errorf("Unexpected PAC info: %v", p)
+	}
+
+	// Once provisioned, the PAC is used without provisioning
+	if resp := fast("eth30", func(*pb.Dot1XConfigRequest) {}); !resp.Success {
+		t.Errorf("Expected success with a provisioned PAC, got %v", resp)
+	}
+
+	// A PAC exported from the server can be imported instead
+	expectFieldError(fast("eth31", func(r *pb.Dot1XConfigRequest) { r.Pac = []byte("not a PAC") }), "pac")
+	if resp := fast("eth31", func(r *pb.Dot1XConfigRequest) { r.Pac = []byte(testPAC) }); !resp.Success {
+		t.Fatalf("Expected success with an imported PAC, got %v", resp)
+	}
+	if got := m.Networks("eth31")[0]["phase1"]; got != "fast_provisioning=0" {
+		t.Errorf("Expected provisioning disabled, got %q", got)
+	}
+	list, _ = client.ListPacs(ctx, &pb.ListPacsRequest{Interfaces: []string{"eth31"}})
+	if len(list.Pacs) != 1 || list.Pacs[0].Interface != "eth31" {
+		t.Errorf("Expected the imported PAC of eth31, got %v", list.Pacs)
+	}
+
+	// PACs survive Disconnect and are deleted by ClearPacs only
+	client.Disconnect(ctx, &pb.InterfaceRequest{Interface: "eth31"})
+	if _, err := client.ClearPacs(ctx, &pb.ClearPacsRequest{}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument without interfaces, got %v", err)
+	}
+	cleared, err := client.ClearPacs(ctx, &pb.ClearPacsRequest{Interfaces: []string{"eth30", "eth31", "eth32"}})
+	if err != nil {
+		t.Fatalf("ClearPacs error: %v", err)
+	}
+	if len(cleared.Cleared) != 2 || cleared.Cleared[0] != "eth30" || cleared.Cleared[1] != "eth31" {
+		t.Errorf("Expected eth30 and eth31 cleared, got %v", cleared.Cleared)
+	}
+	if list, _ := client.ListPacs(ctx, &pb.ListPacsRequest{}); len(list.Pacs) != 0 {
+		t.Errorf("Expected no PACs after ClearPacs, got %v", list.Pacs)
+	}
+	expectFieldError(fast("eth30", func(*pb.Dot1XConfigRequest) {}), "fast_provisioning")
+}
@@ -2,24 +2,41 @@ package test
 
 import (
 	"context"
+	"io"
 	"net"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/credentials"
 	grpcapi "github.com/gavmckee80/dot1x-grpc/internal/grpc"
+	"github.com/gavmckee80/dot1x-grpc/internal/netlink"
 	pb "github.com/gavmckee80/dot1x-grpc/proto"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 )
 
 const bufSize = 1024 * 1024
 
-var lis *bufconn.Listener
+var (
+	lis   *bufconn.Listener
+	mock  *MockSupplicant
+	links *MockLinkInfo
+)
 
 func init() {
 	lis = bufconn.Listen(bufSize)
 	s := grpc.NewServer()
-	manager := core.NewInterfaceManagerWithClient(&MockSupplicant{})
+	mock = &MockSupplicant{}
+	links = &MockLinkInfo{}
+	creds, err := credentials.OpenTempDir()
+	if err != nil {
+		panic(err)
+	}
+	manager := core.NewInterfaceManagerWithClient(mock, core.WithLinkInfoProvider(links), core.WithCredentialDir(creds))
 	service := grpcapi.NewDot1xServiceWithManager(manager)
 	pb.RegisterDot1XManagerServer(s, service)
 	go s.Serve(lis)
@@ -29,6 +46,30 @@ func bufDialer(context.Context, string) (net.Conn, error) {
 	return lis.Dial()
 }
 
+// newTestClient dials the bufconn server and returns a client and a cleanup function.
+func newTestClient(t *testing.T) (pb.Dot1XManagerClient, func()) {
+	t.Helper()
+	conn, err := grpc.DialContext(context.Background(), "bufnet",
+		grpc.WithContextDialer(bufDialer),
+		grpc.WithInsecure())
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	return pb.NewDot1XManagerClient(conn), func() { conn.Close() }
+}
+
+// waitFor polls cond until it returns true or the deadline expires.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("Timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
 func TestConfigureInterfaceTLS(t *testing.T) {
 	ctx := context.Background()
 	conn, err := grpc.DialContext(ctx, "bufnet",
@@ -40,13 +81,14 @@ func TestConfigureInterfaceTLS(t *testing.T) {
 	defer conn.Close()
 	client := pb.NewDot1XManagerClient(conn)
 
+	pki := newTestPKI(t)
 	req := &pb.Dot1XConfigRequest{
 		Interface:  "eth1",
 		EapType:    pb.EapType_EAP_TLS,
 		Identity:   "testuser",
-		CaCert:     []byte("CA CERT"),
-		ClientCert: []byte("CLIENT CERT"),
-		PrivateKey: []byte("PRIVATE KEY"),
+		CaCert:     pki.CA,
+		ClientCert: pki.Cert,
+		PrivateKey: pki.Key,
 	}
 	resp, err := client.ConfigureInterface(ctx, req)
 	if err != nil {
@@ -83,3 +125,455 @@ func TestDisconnect(t *testing.T) {
 		t.Errorf("Expected disconnect success, got: %s", resp.Message)
 	}
 }
+
+func TestGetStatus(t *testing.T) {
+	ctx := context.Background()
+	client, done := newTestClient(t)
+	defer done()
+
+	_, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:  "eth3",
+		EapType:    pb.EapType_EAP_PEAP,
+		Identity:   "carol",
+		Password:   "pass",
+		Phase2Auth: "mschapv2",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+
+	resp, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth3"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if resp.SupplicantState != pb.SupplicantState_SUPPLICANT_STATE_COMPLETED {
+		t.Errorf("Expected COMPLETED state, got %v", resp.SupplicantState)
+	}
+	if resp.EapStatus != pb.EapState_EAP_STATE_SUCCESS {
+		t.Errorf("Expected EAP success, got %v", resp.EapStatus)
+	}
+	if resp.CurrentNetwork == "" {
+		t.Errorf("Expected current network to be reported")
+	}
+
+	mock.SetState("eth3", "authenticating")
+	resp, err = client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth3"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if resp.Status != "authenticating" || resp.EapStatus != pb.EapState_EAP_STATE_IN_PROGRESS {
+		t.Errorf("Expected authenticating/in-progress, got %s/%v", resp.Status, resp.EapStatus)
+	}
+}
+
+func TestGetStatusLinkInfo(t *testing.T) {
+	ctx := context.Background()
+	client, done := newTestClient(t)
+	defer done()
+
+	_, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:  "eth6",
+		EapType:    pb.EapType_EAP_PEAP,
+		Identity:   "frank",
+		Password:   "pass",
+		Phase2Auth: "mschapv2",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+
+	// Without link information the status carries no addresses
+	links.RemoveLink("eth6")
+	resp, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth6"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if resp.IpAddress != "" || len(resp.Ipv4Addresses) != 0 {
+		t.Errorf("Expected no addresses, got %q %v", resp.IpAddress, resp.Ipv4Addresses)
+	}
+
+	links.SetLink(netlink.LinkInfo{
+		Name:      "eth6",
+		MAC:       "02:00:00:00:00:06",
+		Carrier:   true,
+		OperState: "up",
+		IPv4:      []string{"198.51.100.6/24"},
+		IPv6:      []string{"fe80::6/64", "2001:db8::6/64"},
+	})
+	resp, err = client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth6"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if resp.IpAddress != "198.51.100.6" {
+		t.Errorf("Expected primary address 198.51.100.6, got %q", resp.IpAddress)
+	}
+	if len(resp.Ipv4Addresses) != 1 || len(resp.Ipv6Addresses) != 2 {
+		t.Errorf("Expected 1 IPv4 and 2 IPv6 addresses, got %v %v", resp.Ipv4Addresses, resp.Ipv6Addresses)
+	}
+	if !resp.Carrier || resp.MacAddress != "02:00:00:00:00:06" || resp.OperState != "up" {
+		t.Errorf("Unexpected link state: carrier=%v mac=%s oper=%s", resp.Carrier, resp.MacAddress, resp.OperState)
+	}
+}
+
+func TestGetStatusUnmanaged(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+
+	_, err := client.GetStatus(context.Background(), &pb.InterfaceRequest{Interface: "eth99"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
+
+func TestStreamStatus(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:  "eth4",
+		EapType:    pb.EapType_EAP_PEAP,
+		Identity:   "dave",
+		Password:   "pass",
+		Phase2Auth: "mschapv2",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+
+	stream, err := client.StreamStatus(ctx, &pb.InterfaceRequest{Interface: "eth4"})
+	if err != nil {
+		t.Fatalf("StreamStatus error: %v", err)
+	}
+	first, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv snapshot error: %v", err)
+	}
+	if first.SupplicantState != pb.SupplicantState_SUPPLICANT_STATE_COMPLETED {
+		t.Errorf("Expected COMPLETED snapshot, got %v", first.SupplicantState)
+	}
+
+	// A repeated state must not produce an update
+	mock.SetState("eth4", "completed")
+	mock.SetState("eth4", "authenticating")
+	update, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv update error: %v", err)
+	}
+	if update.SupplicantState != pb.SupplicantState_SUPPLICANT_STATE_AUTHENTICATING {
+		t.Errorf("Expected AUTHENTICATING update, got %v", update.SupplicantState)
+	}
+
+	mock.SetState("eth4", "completed")
+	update, err = stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv update error: %v", err)
+	}
+	if update.EapStatus != pb.EapState_EAP_STATE_SUCCESS {
+		t.Errorf("Expected EAP success update, got %v", update.EapStatus)
+	}
+}
+
+func TestStreamStatusUnmanaged(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+
+	stream, err := client.StreamStatus(context.Background(), &pb.InterfaceRequest{Interface: "eth98"})
+	if err != nil {
+		t.Fatalf("StreamStatus error: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound, got %v", err)
+	}
+}
+
+func TestFailedConfigureLeavesInterfaceUnmanaged(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{FailSelect: true}
+	client := newIsolatedClient(t, m)
+
+	req := &pb.Dot1XConfigRequest{Interface: "eth18", EapType: pb.EapType_EAP_PEAP, Identity: "frank", Password: "pw"}
+	if resp, err := client.ConfigureInterface(ctx, req); err != nil || resp.Success {
+		t.Fatalf("Expected the configuration to fail, got %v %v", resp, err)
+	}
+	if _, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth18"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound after a failed configuration, got %v", err)
+	}
+	if nets := m.Networks("eth18"); len(nets) != 0 {
+		t.Errorf("Expected the networks to be removed, got %v", nets)
+	}
+
+	m.FailSelect = false
+	if resp, err := client.ConfigureInterface(ctx, req); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if _, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth18"}); err != nil {
+		t.Errorf("Expected eth18 to be managed: %v", err)
+	}
+}
+
+func TestStreamEapEvents(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:  "eth5",
+		EapType:    pb.EapType_EAP_PEAP,
+		Identity:   "erin",
+		Password:   "pass",
+		Phase2Auth: "mschapv2",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+
+	stream, err := client.StreamEapEvents(ctx, &pb.InterfaceRequest{Interface: "eth5"})
+	if err != nil {
+		t.Fatalf("StreamEapEvents error: %v", err)
+	}
+	waitFor(t, "EAP subscription", func() bool { return mock.EAPSubscribers("eth5") > 0 })
+
+	mock.EmitEAP("eth5", "started", "")
+	mock.EmitEAP("eth5", "method", "PEAP")
+	mock.EmitEAP("eth5", "completion", "failure")
+
+	want := []struct {
+		typ   pb.EapEventType
+		param string
+	}{
+		{pb.EapEventType_EAP_EVENT_STARTED, ""},
+		{pb.EapEventType_EAP_EVENT_METHOD_SELECTED, "PEAP"},
+		{pb.EapEventType_EAP_EVENT_COMPLETION, "failure"},
+	}
+	for _, w := range want {
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatalf("Recv error: %v", err)
+		}
+		if ev.Type != w.typ || ev.Parameter != w.param || ev.Interface != "eth5" {
+			t.Errorf("Expected %v(%s), got %v(%s) on %s", w.typ, w.param, ev.Type, ev.Parameter, ev.Interface)
+		}
+		if ev.Timestamp == 0 {
+			t.Errorf("Expected event timestamp")
+		}
+	}
+}
+
+func TestStreamAllStatus(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	configure := func(iface string) {
+		_, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+			Interface:  iface,
+			EapType:    pb.EapType_EAP_PEAP,
+			Identity:   "grace",
+			Password:   "pass",
+			Phase2Auth: "mschapv2",
+		})
+		if err != nil {
+			t.Fatalf("ConfigureInterface %s error: %v", iface, err)
+		}
+	}
+	// recvUntil reads updates until one matches, failing on interfaces outside the filter.
+	recvUntil := func(stream pb.Dot1XManager_StreamAllStatusClient, iface string, state pb.SupplicantState) {
+		t.Helper()
+		for {
+			st, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("Recv error waiting for %s: %v", iface, err)
+			}
+			if st.Interface != "eth10" && st.Interface != "eth11" {
+				t.Fatalf("Received update for filtered-out interface %s", st.Interface)
+			}
+			if st.Interface == iface && st.SupplicantState == state {
+				return
+			}
+		}
+	}
+
+	configure("eth10")
+	stream, err := client.StreamAllStatus(ctx, &pb.StatusFilter{Interfaces: []string{"eth10", "eth11"}})
+	if err != nil {
+		t.Fatalf("StreamAllStatus error: %v", err)
+	}
+	recvUntil(stream, "eth10", pb.SupplicantState_SUPPLICANT_STATE_COMPLETED)
+
+	// Interfaces configured after the stream opened are picked up
+	configure("eth11")
+	recvUntil(stream, "eth11", pb.SupplicantState_SUPPLICANT_STATE_COMPLETED)
+
+	// Interfaces outside the filter are not streamed
+	configure("eth12")
+	mock.SetState("eth12", "authenticating")
+	mock.SetState("eth10", "authenticating")
+	recvUntil(stream, "eth10", pb.SupplicantState_SUPPLICANT_STATE_AUTHENTICATING)
+}
+
+// watchProgress runs ConfigureAndWatch and collects progress until the stream ends.
+func watchProgress(t *testing.T, client pb.Dot1XManagerClient, req *pb.ConfigureAndWatchRequest) []*pb.ConfigureProgress {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	stream, err := client.ConfigureAndWatch(ctx, req)
+	if err != nil {
+		t.Fatalf("ConfigureAndWatch error: %v", err)
+	}
+	var got []*pb.ConfigureProgress
+	for {
+		p, err := stream.Recv()
+		if err == io.EOF {
+			return got
+		}
+		if err != nil {
+			t.Fatalf("Recv error: %v", err)
+		}
+		got = append(got, p)
+	}
+}
+
+func TestConfigureAndWatch(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+
+	links.SetLink(netlink.LinkInfo{Name: "eth13", Carrier: true, IPv4: []string{"198.51.100.13/24"}})
+	got := watchProgress(t, client, &pb.ConfigureAndWatchRequest{
+		Config: &pb.Dot1XConfigRequest{
+			Interface:  "eth13",
+			EapType:    pb.EapType_EAP_PEAP,
+			Identity:   "heidi",
+			Password:   "pass",
+			Phase2Auth: "mschapv2",
+		},
+		TimeoutSeconds: 5,
+		WaitForIp:      true,
+	})
+	if len(got) < 4 {
+		t.Fatalf("Expected at least 4 progress messages, got %d", len(got))
+	}
+	if got[0].Stage != pb.ConfigureStage_CONFIGURE_STAGE_NETWORK_ADDED ||
+		got[1].Stage != pb.ConfigureStage_CONFIGURE_STAGE_NETWORK_SELECTED {
+		t.Errorf("Unexpected initial stages: %v, %v", got[0].Stage, got[1].Stage)
+	}
+	for i := 1; i < len(got); i++ {
+		if got[i].Stage < got[i-1].Stage {
+			t.Errorf("Stage went backwards: %v after %v", got[i].Stage, got[i-1].Stage)
+		}
+	}
+	last := got[len(got)-1]
+	if last.Stage != pb.ConfigureStage_CONFIGURE_STAGE_IP_ACQUIRED || !last.Terminal || !last.Success {
+		t.Errorf("Expected terminal IP_ACQUIRED success, got %v terminal=%v success=%v", last.Stage, last.Terminal, last.Success)
+	}
+}
+
+func TestConfigureAndWatchFailure(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+
+	mock.FailAuth("eth14", true)
+	defer mock.FailAuth("eth14", false)
+	got := watchProgress(t, client, &pb.ConfigureAndWatchRequest{
+		Config: &pb.Dot1XConfigRequest{
+			Interface:  "eth14",
+			EapType:    pb.EapType_EAP_PEAP,
+			Identity:   "ivan",
+			Password:   "wrong",
+			Phase2Auth: "mschapv2",
+		},
+		TimeoutSeconds: 5,
+	})
+	last := got[len(got)-1]
+	if last.Stage != pb.ConfigureStage_CONFIGURE_STAGE_FAILED || !last.Terminal || last.Success {
+		t.Errorf("Expected terminal FAILED, got %v terminal=%v success=%v", last.Stage, last.Terminal, last.Success)
+	}
+}
+
+func TestConfigureAndWatchReconfigurePending(t *testing.T) {
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	req := &pb.Dot1XConfigRequest{Interface: "eth17", EapType: pb.EapType_EAP_PEAP, Identity: "kim", Password: "pw"}
+	if resp, err := client.ConfigureInterface(context.Background(), req); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+
+	// The new network becomes current while the interface is still completed
+	m.HoldAuth = true
+	req.Identity = "kim2"
+	got := watchProgress(t, client, &pb.ConfigureAndWatchRequest{Config: req, TimeoutSeconds: 1})
+	for _, p := range got {
+		if p.Stage == pb.ConfigureStage_CONFIGURE_STAGE_AUTHENTICATED {
+			t.Errorf("Expected no success before the new network authenticates, got %v", got)
+		}
+	}
+	if last := got[len(got)-1]; last.Stage != pb.ConfigureStage_CONFIGURE_STAGE_TIMED_OUT {
+		t.Errorf("Expected TIMED_OUT, got %v", last)
+	}
+}
+
+func TestConfigureAndWatchInvalid(t *testing.T) {
+	client, done := newTestClient(t)
+	defer done()
+
+	got := watchProgress(t, client, &pb.ConfigureAndWatchRequest{
+		Config: &pb.Dot1XConfigRequest{Interface: "eth15", EapType: pb.EapType_EAP_PEAP},
+	})
+	if len(got) != 1 || got[0].Stage != pb.ConfigureStage_CONFIGURE_STAGE_FAILED || !got[0].Terminal {
+		t.Errorf("Expected a single terminal FAILED message, got %v", got)
+	}
+}
+
+func TestReconfigureReplacesNetwork(t *testing.T) {
+	ctx := context.Background()
+	client, done := newTestClient(t)
+	defer done()
+
+	pki := newTestPKI(t)
+	req := &pb.Dot1XConfigRequest{
+		Interface:  "eth16",
+		EapType:    pb.EapType_EAP_TLS,
+		Identity:   "judy",
+		CaCert:     pki.CA,
+		ClientCert: pki.Cert,
+		PrivateKey: pki.Key,
+	}
+	if resp, err := client.ConfigureInterface(ctx, req); err != nil || !resp.Success {
+		t.Fatalf("First configure failed: %v %v", err, resp)
+	}
+	first := mock.Networks("eth16")
+	if len(first) != 1 {
+		t.Fatalf("Expected 1 network after first configure, got %d", len(first))
+	}
+
+	req.Identity = "judy2"
+	if resp, err := client.ConfigureInterface(ctx, req); err != nil || !resp.Success {
+		t.Fatalf("Second configure failed: %v %v", err, resp)
+	}
+	second := mock.Networks("eth16")
+	if len(second) != 1 || second[0]["identity"] != "judy2" {
+		t.Fatalf("Expected only the new network to remain, got %v", second)
+	}
+
+	// Credentials are passed as blobs, which are released with their network
+	for _, key := range []string{"ca_cert", "client_cert", "private_key"} {
+		oldBlob := strings.TrimPrefix(first[0][key], "blob://")
+		newBlob := strings.TrimPrefix(second[0][key], "blob://")
+		if oldBlob == first[0][key] || newBlob == second[0][key] {
+			t.Fatalf("Expected %s to reference a blob, got %q and %q", key, first[0][key], second[0][key])
+		}
+		if mock.Blob("eth16", oldBlob) != nil {
+			t.Errorf("Expected old %s blob %s to be removed", key, oldBlob)
+		}
+		if mock.Blob("eth16", newBlob) == nil {
+			t.Errorf("Expected new %s blob %s to exist", key, newBlob)
+		}
+	}
+	if got := string(mock.Blob("eth16", strings.TrimPrefix(second[0]["private_key"], "blob://"))); got != string(pki.Key) {
+		t.Errorf("Expected private key blob content, got %q", got)
+	}
+}
@@ -0,0 +1,186 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestInterfaceOptions(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+
+	configure := func(opts *pb.InterfaceOptions) {
+		t.Helper()
+		resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+			Interface:        "eth80",
+			EapType:          pb.EapType_EAP_PEAP,
+			Identity:         "alice",
+			Password:         "pw",
+			InterfaceOptions: opts,
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("Configure failed: %v %v", err, resp)
+		}
+	}
+
+	// A bridge member receives EAPOL frames on its bridge
+	fastReauth := false
+	configure(&pb.InterfaceOptions{BridgeInterface: "br0", EapolVersion: 2, FastReauth: &fastReauth})
+	opts := m.Options("eth80")
+	if opts.Driver != "wired" || opts.BridgeIfname != "br0" || opts.EapolVersion != 2 || opts.FastReauth == nil || *opts.FastReauth {
+		t.Errorf("Expected the wired driver on bridge br0 with EAPOL v2 and no fast reauthentication, got %+v", opts)
+	}
+	st, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth80"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if o := st.InterfaceOptions; o.GetBridgeInterface() != "br0" || o.GetDriver() != "wired" || o.GetEapolVersion() != 2 {
+		t.Errorf("Expected the interface options in the status, got %v", o)
+	}
+
+	// The same options reuse the interface; EAPOL settings left unset match any value
+	created := len(m.Created)
+	configure(&pb.InterfaceOptions{BridgeInterface: "br0"})
+	if len(m.Created) != created {
+		t.Errorf("Expected the interface to be reused, got created %v", m.Created)
+	}
+	if nets := m.Networks("eth80"); len(nets) != 1 {
+		t.Errorf("Expected the network to be replaced, got %d networks", len(nets))
+	}
+
+	// Another bridge or configuration file re-creates it
+	conf := filepath.Join(t.TempDir(), "eth80.conf")
+	if err := os.WriteFile(conf, []byte("ctrl_interface=/run/wpa_supplicant\n"), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	configure(&pb.InterfaceOptions{Driver: "wired", ConfigFile: conf})
+	if opts := m.Options("eth80"); opts.BridgeIfname != "" || opts.ConfigFile != conf {
+		t.Errorf("Expected no bridge and config file %s, got %+v", conf, opts)
+	}
+	if len(m.Created) != created+1 {
+		t.Errorf("Expected the interface to be re-created once, got created %v", m.Created)
+	}
+	if nets := m.Networks("eth80"); len(nets) != 1 {
+		t.Errorf("Expected 1 network after re-creating the interface, got %d", len(nets))
+	}
+}
+
+func TestForeignInterfaceKept(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+
+	// An interface wpa_supplicant was started with, from its own configuration file
+	conf := filepath.Join(t.TempDir(), "eth82.conf")
+	if err := os.WriteFile(conf, []byte("ctrl_interface=/run/wpa_supplicant\n"), 0600); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	foreign := dbus.InterfaceOptions{Driver: "wired", ConfigFile: conf}
+	m.CreateInterface("eth82", foreign)
+
+	resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth82",
+		EapType:   pb.EapType_EAP_PEAP,
+		Identity:  "alice",
+		Password:  "pw",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+	if resp.Success || !strings.Contains(resp.Message, "config_file") {
+		t.Errorf("Expected a failure naming config_file, got %v", resp)
+	}
+	if len(m.Removed) != 0 || m.Options("eth82") != foreign {
+		t.Errorf("Expected the interface to be left alone, got removed %v and options %+v", m.Removed, m.Options("eth82"))
+	}
+	if _, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth82"}); err == nil {
+		t.Errorf("Expected eth82 not to be managed")
+	}
+
+	// The same options use it as it is
+	resp, err = client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:        "eth82",
+		EapType:          pb.EapType_EAP_PEAP,
+		Identity:         "alice",
+		Password:         "pw",
+		InterfaceOptions: &pb.InterfaceOptions{ConfigFile: foreign.ConfigFile},
+	})
+	if err != nil || !resp.Success {
+		t.Errorf("Expected the interface to be reused with its options: %v %v", err, resp)
+	}
+}
+
+func TestInterfaceOptionsValidation(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	apScan := uint32(3)
+
+	tests := []struct {
+		name   string
+		opts   *pb.InterfaceOptions
+		macsec *pb.MacsecConfig
+		fields []string
+	}{
+		{
+			name:   "driver name",
+			opts:   &pb.InterfaceOptions{Driver: "wired -c /etc/passwd"},
+			fields: []string{"interface_options.driver"},
+		},
+		{
+			name:   "bridge is the interface",
+			opts:   &pb.InterfaceOptions{BridgeInterface: "eth81"},
+			fields: []string{"interface_options.bridge_interface"},
+		},
+		{
+			name:   "relative config file",
+			opts:   &pb.InterfaceOptions{ConfigFile: "wpa_supplicant.conf"},
+			fields: []string{"interface_options.config_file"},
+		},
+		{
+			name:   "EAPOL settings",
+			opts:   &pb.InterfaceOptions{EapolVersion: 4, ApScan: &apScan},
+			fields: []string{"interface_options.eapol_version", "interface_options.ap_scan"},
+		},
+		{
+			name:   "MACsec without a MACsec driver",
+			opts:   &pb.InterfaceOptions{Driver: "wired"},
+			macsec: &pb.MacsecConfig{Mode: pb.MacsecMode_MACSEC_MODE_EAP},
+			fields: []string{"interface_options.driver"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+				Interface:        "eth81",
+				EapType:          pb.EapType_EAP_PEAP,
+				Identity:         "alice",
+				Password:         "pw",
+				Macsec:           tt.macsec,
+				InterfaceOptions: tt.opts,
+			})
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+			if resp.Success || len(resp.FieldErrors) != len(tt.fields) {
+				t.Fatalf("Expected errors on %v, got %v", tt.fields, resp)
+			}
+			for i, fe := range resp.FieldErrors {
+				if fe.Field != tt.fields[i] {
+					t.Errorf("Expected error on %s, got %s: %s", tt.fields[i], fe.Field, fe.Reason)
+				}
+			}
+		})
+	}
+	if len(m.Created) != 0 {
+		t.Errorf("Expected no interface to be created for invalid options, got %v", m.Created)
+	}
+}
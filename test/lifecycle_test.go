@@ -0,0 +1,119 @@
+package test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSupplicantRestartReappliesConfig(t *testing.T) {
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for _, iface := range []string{"eth1", "eth2"} {
+		resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+			Interface:  iface,
+			EapType:    pb.EapType_EAP_PEAP,
+			Identity:   "svc-" + iface,
+			Password:   "secret",
+			Phase2Auth: "mschapv2",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("Configure %s failed: %v %v", iface, err, resp)
+		}
+	}
+	if _, err := client.Disconnect(ctx, &pb.InterfaceRequest{Interface: "eth2"}); err != nil {
+		t.Fatalf("Disconnect error: %v", err)
+	}
+
+	stream, err := client.StreamStatus(ctx, &pb.InterfaceRequest{Interface: "eth1"})
+	if err != nil {
+		t.Fatalf("StreamStatus error: %v", err)
+	}
+	if st, err := stream.Recv(); err != nil || st.LastEvent != "snapshot" {
+		t.Fatalf("Expected snapshot, got %v %v", st, err)
+	}
+
+	m.Restart()
+
+	st, err := stream.Recv()
+	if err != nil || st.LastEvent != "supplicant-stopped" {
+		t.Fatalf("Expected supplicant-stopped, got %v %v", st, err)
+	}
+	st, err = stream.Recv()
+	if err != nil || st.LastEvent != "supplicant-restarted" {
+		t.Fatalf("Expected supplicant-restarted, got %v %v", st, err)
+	}
+	if st.SupplicantState != pb.SupplicantState_SUPPLICANT_STATE_COMPLETED {
+		t.Errorf("Expected eth1 to authenticate again, got %v", st.SupplicantState)
+	}
+
+	nets := m.Networks("eth1")
+	if len(nets) != 1 || nets[0]["identity"] != "svc-eth1" {
+		t.Errorf("Expected eth1 network to be re-applied, got %v", nets)
+	}
+	if len(m.Networks("eth2")) != 0 {
+		t.Errorf("Expected disconnected eth2 not to be re-applied")
+	}
+	if _, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth2"}); status.Code(err) != codes.NotFound {
+		t.Errorf("Expected NotFound for eth2 after restart, got %v", err)
+	}
+
+	// The stream keeps following the interface after the restart
+	m.SetState("eth1", "disconnected")
+	st, err = stream.Recv()
+	if err != nil || st.LastEvent != "state-changed" || st.Status != "disconnected" {
+		t.Errorf("Expected state-changed after restart, got %v %v", st, err)
+	}
+}
+
+func TestStreamAllStatusFollowsReconfiguredInterface(t *testing.T) {
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := &pb.Dot1XConfigRequest{Interface: "eth3", EapType: pb.EapType_EAP_PEAP, Identity: "svc-eth3", Password: "secret"}
+	if resp, err := client.ConfigureInterface(ctx, req); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	stream, err := client.StreamAllStatus(ctx, &pb.StatusFilter{})
+	if err != nil {
+		t.Fatalf("StreamAllStatus error: %v", err)
+	}
+	recv := func(event string) {
+		t.Helper()
+		for {
+			st, err := stream.Recv()
+			if err != nil {
+				t.Fatalf("Expected %s, got %v", event, err)
+			}
+			if st.Interface == "eth3" && st.LastEvent == event {
+				return
+			}
+		}
+	}
+	recv("snapshot")
+
+	// A disconnected interface is no longer managed after a restart, which ends its watch
+	if _, err := client.Disconnect(ctx, &pb.InterfaceRequest{Interface: "eth3"}); err != nil {
+		t.Fatalf("Disconnect error: %v", err)
+	}
+	m.Restart()
+	waitFor(t, "eth3 to be dropped", func() bool {
+		_, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth3"})
+		return status.Code(err) == codes.NotFound
+	})
+
+	// Configuring it again brings it back into the stream
+	if resp, err := client.ConfigureInterface(ctx, req); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	recv("snapshot")
+}
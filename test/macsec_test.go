@@ -0,0 +1,181 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/netlink"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestConfigureMACsec(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	links := &MockLinkInfo{}
+	client := newIsolatedClient(t, m, core.WithLinkInfoProvider(links))
+
+	// Plain 802.1X first, on the wired driver
+	resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth70",
+		EapType:   pb.EapType_EAP_PEAP,
+		Identity:  "alice",
+		Password:  "pw",
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if d := m.Driver("eth70"); d != "wired" {
+		t.Fatalf("Expected the wired driver, got %s", d)
+	}
+
+	// MACsec keyed by EAP moves the interface to the macsec_linux driver
+	priority := uint32(0)
+	resp, err = client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth70",
+		EapType:   pb.EapType_EAP_PEAP,
+		Identity:  "alice",
+		Password:  "pw",
+		Macsec:    &pb.MacsecConfig{Mode: pb.MacsecMode_MACSEC_MODE_EAP, MustSecure: true, MkaPriority: &priority},
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if d := m.Driver("eth70"); d != "macsec_linux" {
+		t.Errorf("Expected the macsec_linux driver, got %s", d)
+	}
+	nets := m.Networks("eth70")
+	if len(nets) != 1 {
+		t.Fatalf("Expected only the MACsec network after re-creating the interface, got %v", nets)
+	}
+	for key, want := range map[string]string{"eap": "PEAP", "key_mgmt": "IEEE8021X", "macsec_policy": "1", "mka_priority": "0"} {
+		if got := nets[0][key]; got != want {
+			t.Errorf("Expected %s=%q, got %q", key, want, got)
+		}
+	}
+
+	// Status reports the secure channel once wpa_supplicant has created the MACsec link
+	links.SetLink(netlink.LinkInfo{Name: "eth70", OperState: "up", Carrier: true, MACsec: "macsec0"})
+	links.SetLink(netlink.LinkInfo{Name: "macsec0", OperState: "up", Carrier: true})
+	st, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth70"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	ms := st.Macsec
+	if ms == nil || ms.Mode != pb.MacsecMode_MACSEC_MODE_EAP || ms.Driver != "macsec_linux" ||
+		ms.Interface != "macsec0" || !ms.Secured || ms.OperState != "up" {
+		t.Errorf("Expected a secured MACsec status on macsec0, got %v", ms)
+	}
+
+	// A pre-shared CAK replaces EAP
+	cak := bytes.Repeat([]byte{0xab}, 16)
+	ckn := []byte{0x01, 0x02, 0x03, 0x04}
+	resp, err = client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth70",
+		Macsec:    &pb.MacsecConfig{Mode: pb.MacsecMode_MACSEC_MODE_PSK, MkaCak: cak, MkaCkn: ckn, Port: 2},
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	nets = m.Networks("eth70")
+	if len(nets) != 1 {
+		t.Fatalf("Expected 1 network, got %d", len(nets))
+	}
+	want := map[string]string{
+		"key_mgmt":      "NONE",
+		"macsec_policy": "0",
+		"macsec_port":   "2",
+		"mka_cak":       hex.EncodeToString(cak),
+		"mka_ckn":       "01020304",
+	}
+	for key, w := range want {
+		if got := nets[0][key]; got != w {
+			t.Errorf("Expected %s=%q, got %q", key, w, got)
+		}
+	}
+	if eap, ok := nets[0]["eap"]; ok {
+		t.Errorf("Expected no EAP method with a pre-shared CAK, got %q", eap)
+	}
+
+	// Without MACsec the interface goes back to the wired driver
+	resp, err = client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth70",
+		EapType:   pb.EapType_EAP_PEAP,
+		Identity:  "alice",
+		Password:  "pw",
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if d := m.Driver("eth70"); d != "wired" {
+		t.Errorf("Expected the wired driver, got %s", d)
+	}
+	st, err = client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth70"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if st.Macsec != nil {
+		t.Errorf("Expected no MACsec status, got %v", st.Macsec)
+	}
+}
+
+func TestMACsecValidation(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	priority := uint32(300)
+
+	tests := []struct {
+		name   string
+		req    *pb.Dot1XConfigRequest
+		fields []string
+	}{
+		{
+			name:   "method without keys",
+			req:    &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_MD5, Identity: "lab", Password: "pw", Macsec: &pb.MacsecConfig{Mode: pb.MacsecMode_MACSEC_MODE_EAP}},
+			fields: []string{"macsec.mode"},
+		},
+		{
+			name: "CAK with EAP keys",
+			req: &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PEAP, Identity: "alice", Password: "pw", Macsec: &pb.MacsecConfig{
+				Mode: pb.MacsecMode_MACSEC_MODE_EAP, MkaCak: make([]byte, 16), MkaPriority: &priority,
+			}},
+			fields: []string{"macsec.mka_cak", "macsec.mka_priority"},
+		},
+		{
+			name: "short pre-shared key with EAP",
+			req: &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PEAP, Identity: "alice", Macsec: &pb.MacsecConfig{
+				Mode: pb.MacsecMode_MACSEC_MODE_PSK, MkaCak: make([]byte, 10),
+			}},
+			fields: []string{"eap_type", "identity", "macsec.mka_cak", "macsec.mka_ckn"},
+		},
+		{
+			name:   "settings while disabled",
+			req:    &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PEAP, Identity: "alice", Password: "pw", Macsec: &pb.MacsecConfig{MustSecure: true}},
+			fields: []string{"macsec.mode"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Interface = "eth71"
+			resp, err := client.ConfigureInterface(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+			if resp.Success || len(resp.FieldErrors) != len(tt.fields) {
+				t.Fatalf("Expected errors on %v, got %v", tt.fields, resp)
+			}
+			for i, fe := range resp.FieldErrors {
+				if fe.Field != tt.fields[i] {
+					t.Errorf("Expected error on %s, got %s: %s", tt.fields[i], fe.Field, fe.Reason)
+				}
+			}
+		})
+	}
+	if d := m.Driver("eth71"); d != "wired" {
+		t.Errorf("Expected a rejected configuration to leave the driver alone, got %s", d)
+	}
+}
@@ -0,0 +1,198 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestConfigurePasswordMethods(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	pki := newTestPKI(t)
+
+	tests := []struct {
+		name   string
+		req    *pb.Dot1XConfigRequest
+		want   map[string]string // Expected network fields; nil if rejected
+		fields []string          // Expected field errors
+	}{
+		{
+			name: "TEAP default inner method",
+			req:  &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_TEAP, Identity: "alice", Password: "pw"},
+			want: map[string]string{"eap": "TEAP", "password": "pw", "phase2": "auth=MSCHAPV2"},
+		},
+		{
+			name: "TEAP GTC with CA",
+			req:  &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_TEAP, Identity: "alice", Password: "pw", Phase2Auth: "gtc", CaCert: pki.CA},
+			want: map[string]string{"eap": "TEAP", "phase2": "auth=GTC"},
+		},
+		{
+			name: "PWD",
+			req:  &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PWD, Identity: "alice", Password: "pw"},
+			want: map[string]string{"eap": "PWD", "password": "pw"},
+		},
+		{
+			name:   "PWD with certificates",
+			req:    &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PWD, Identity: "alice", Password: "pw", CaCert: pki.CA, ClientCert: pki.Cert},
+			fields: []string{"client_cert", "ca_cert"},
+		},
+		{
+			name: "MD5",
+			req:  &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_MD5, Identity: "lab", Password: "pw"},
+			want: map[string]string{"eap": "MD5", "identity": "lab", "password": "pw"},
+		},
+		{
+			name:   "MD5 without password",
+			req:    &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_MD5, Identity: "lab"},
+			fields: []string{"password"},
+		},
+		{
+			name: "GTC",
+			req:  &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_GTC, Identity: "token", Password: "123456"},
+			want: map[string]string{"eap": "GTC", "password": "123456"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Interface = "eth40"
+			resp, err := client.ConfigureInterface(ctx, tt.req)
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+
+			if tt.want == nil {
+				if resp.Success || len(resp.FieldErrors) != len(tt.fields) {
+					t.Fatalf("Expected errors on %v, got %v", tt.fields, resp)
+				}
+				for i, fe := range resp.FieldErrors {
+					if fe.Field != tt.fields[i] {
+						t.Errorf("Expected error on %s, got %s: %s", tt.fields[i], fe.Field, fe.Reason)
+					}
+				}
+				return
+			}
+
+			if !resp.Success {
+				t.Fatalf("Expected success, got %v", resp)
+			}
+			nets := m.Networks("eth40")
+			if len(nets) != 1 {
+				t.Fatalf("Expected 1 network, got %d", len(nets))
+			}
+			for key, want := range tt.want {
+				if got := nets[0][key]; got != want {
+					t.Errorf("Expected %s=%q, got %q", key, want, got)
+				}
+			}
+			if tt.req.EapType != pb.EapType_EAP_TEAP {
+				if phase2, ok := nets[0]["phase2"]; ok {
+					t.Errorf("Expected no phase2 for %s, got %q", tt.req.EapType, phase2)
+				}
+			}
+		})
+	}
+}
+
+func TestEapMethodSupport(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{Methods: []string{"MD5", "TLS", "MSCHAPV2", "PEAP", "TTLS", "GTC"}}
+	client := newIsolatedClient(t, m)
+
+	resp, err := client.ListEapMethods(ctx, &pb.ListEapMethodsRequest{})
+	if err != nil {
+		t.Fatalf("ListEapMethods error: %v", err)
+	}
+	want := []pb.EapType{pb.EapType_EAP_TLS, pb.EapType_EAP_PEAP, pb.EapType_EAP_TTLS, pb.EapType_EAP_MD5, pb.EapType_EAP_GTC}
+	if len(resp.Supported) != len(want) {
+		t.Fatalf("Expected supported %v, got %v", want, resp.Supported)
+	}
+	for i := range want {
+		if resp.Supported[i] != want[i] {
+			t.Errorf("Expected supported %v, got %v", want, resp.Supported)
+			break
+		}
+	}
+	if len(resp.SupplicantMethods) != len(m.Methods) {
+		t.Errorf("Expected wpa_supplicant methods %v, got %v", m.Methods, resp.SupplicantMethods)
+	}
+
+	// A method wpa_supplicant was built without is rejected before any network is added
+	cfg, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth41",
+		EapType:   pb.EapType_EAP_TEAP,
+		Identity:  "alice",
+		Password:  "pw",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+	if cfg.Success || len(cfg.FieldErrors) != 1 || cfg.FieldErrors[0].Field != "eap_type" {
+		t.Errorf("Expected an eap_type error, got %v", cfg)
+	}
+	if nets := m.Networks("eth41"); len(nets) != 0 {
+		t.Errorf("Expected no network for an unsupported method, got %v", nets)
+	}
+}
+
+func TestAnonymousIdentity(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+
+	resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:         "eth42",
+		EapType:           pb.EapType_EAP_TTLS,
+		Identity:          "alice@corp.example",
+		AnonymousIdentity: "anonymous@corp.example",
+		Password:          "pw",
+		Phase2Auth:        "mschapv2",
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if got := m.Networks("eth42")[0]["anonymous_identity"]; got != "anonymous@corp.example" {
+		t.Errorf("Expected anonymous_identity in the network, got %q", got)
+	}
+
+	// The applied identities are reported for auditing
+	st, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "eth42"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if st.EapType != pb.EapType_EAP_TTLS || st.Identity != "alice@corp.example" || st.AnonymousIdentity != "anonymous@corp.example" {
+		t.Errorf("Expected the applied method and identities in the status, got %v", st)
+	}
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := client.StreamStatus(streamCtx, &pb.InterfaceRequest{Interface: "eth42"})
+	if err != nil {
+		t.Fatalf("StreamStatus error: %v", err)
+	}
+	snapshot, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Recv error: %v", err)
+	}
+	if snapshot.AnonymousIdentity != "anonymous@corp.example" {
+		t.Errorf("Expected the anonymous identity in the stream snapshot, got %q", snapshot.AnonymousIdentity)
+	}
+
+	// Methods without a tunnel send the identity itself
+	resp, err = client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:         "eth42",
+		EapType:           pb.EapType_EAP_MD5,
+		Identity:          "lab",
+		AnonymousIdentity: "anonymous",
+		Password:          "pw",
+	})
+	if err != nil {
+		t.Fatalf("ConfigureInterface error: %v", err)
+	}
+	if resp.Success || len(resp.FieldErrors) != 1 || resp.FieldErrors[0].Field != "anonymous_identity" {
+		t.Errorf("Expected an anonymous_identity error, got %v", resp)
+	}
+}
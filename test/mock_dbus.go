@@ -2,40 +2,621 @@ package test
 
 import (
 	"errors"
+	"fmt"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/godbus/dbus/v5"
+	godbus "github.com/godbus/dbus/v5"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
 )
 
 type MockSupplicant struct {
 	Created []string
+	Removed []godbus.ObjectPath
+
+	// OpDelay slows down network operations to widen race windows in tests.
+	OpDelay time.Duration
+
+	// ConcurrentPorts makes AddNetwork wait, for up to a second, until this many
+	// interfaces have a configuration in progress, so serialized ports show up as
+	// a lower Peak instead of a slower test.
+	ConcurrentPorts int
+
+	// NoBlobs makes AddBlob fail, as with a wpa_supplicant that rejects blobs.
+	NoBlobs bool
+
+	// HoldAuth makes SelectNetwork only switch the current network, leaving the
+	// state and authentication outcome pending as while wpa_supplicant associates.
+	HoldAuth bool
+
+	// FailSelect makes SelectNetwork fail.
+	FailSelect bool
+
+	// Methods lists the EAP methods reported by EapMethods; nil reports every method.
+	Methods []string
+
+	mu       sync.Mutex
+	options  map[godbus.ObjectPath]dbus.InterfaceOptions
+	states   map[godbus.ObjectPath]*dbus.InterfaceState
+	networks map[godbus.ObjectPath]map[string]string
+	blobs    map[godbus.ObjectPath]map[string][]byte
+	bsss     map[godbus.ObjectPath]dbus.BSS
+	nextNet  int
+	authFail map[godbus.ObjectPath]bool
+	rejected map[godbus.ObjectPath]map[string]bool
+	logoff   map[godbus.ObjectPath]bool
+	pending  map[godbus.ObjectPath]bool
+	overlaps int
+	peak     int
+	stopped  bool
+	propSub  mockSignals[map[string]godbus.Variant]
+	eapSub   mockSignals[dbus.EAPEvent]
+	ownerSub mockSignals[dbus.NameOwnerChange]
 }
 
-func (m *MockSupplicant) CreateInterface(ifname string) (dbus.ObjectPath, error) {
+// errMockStopped is returned for calls made while wpa_supplicant is stopped.
+var errMockStopped = errors.New("org.freedesktop.DBus.Error.ServiceUnknown: wpa_supplicant is not running")
+
+// mockBusPath is the object that emits NameOwnerChanged signals.
+const mockBusPath = godbus.ObjectPath("/org/freedesktop/DBus")
+
+// mockSignals emulates per-object D-Bus signal subscriptions. Callers hold MockSupplicant.mu.
+type mockSignals[T any] struct {
+	subs map[godbus.ObjectPath]map[int]chan T
+	next int
+}
+
+func (s *mockSignals[T]) subscribe(mu *sync.Mutex, path godbus.ObjectPath) (<-chan T, func()) {
+	if s.subs == nil {
+		s.subs = make(map[godbus.ObjectPath]map[int]chan T)
+	}
+	if s.subs[path] == nil {
+		s.subs[path] = make(map[int]chan T)
+	}
+	id := s.next
+	s.next++
+	ch := make(chan T, 32)
+	s.subs[path][id] = ch
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			mu.Lock()
+			defer mu.Unlock()
+			delete(s.subs[path], id)
+			close(ch)
+		})
+	}
+}
+
+func (s *mockSignals[T]) publish(path godbus.ObjectPath, v T) {
+	for _, ch := range s.subs[path] {
+		select {
+		case ch <- v:
+		default:
+		}
+	}
+}
+
+func (m *MockSupplicant) CreateInterface(ifname string, opts dbus.InterfaceOptions) (godbus.ObjectPath, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopped {
+		return "", errMockStopped
+	}
 	m.Created = append(m.Created, ifname)
-	return dbus.ObjectPath("/mock/" + ifname), nil
+	ifacePath := godbus.ObjectPath("/mock/" + ifname)
+	if m.options == nil {
+		m.options = make(map[godbus.ObjectPath]dbus.InterfaceOptions)
+	}
+	m.options[ifacePath] = opts
+	return ifacePath, nil
 }
 
-func (m *MockSupplicant) RemoveInterface(path dbus.ObjectPath) error {
+// RemoveInterface drops an interface with its options, networks, blobs and logoff.
+func (m *MockSupplicant) RemoveInterface(ifacePath godbus.ObjectPath) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.Removed = append(m.Removed, ifacePath)
+	delete(m.options, ifacePath)
+	delete(m.blobs, ifacePath)
+	delete(m.logoff, ifacePath)
+	for netPath := range m.networks {
+		if strings.HasPrefix(string(netPath), string(ifacePath)+"/") {
+			delete(m.networks, netPath)
+		}
+	}
 	return nil
 }
 
-func (m *MockSupplicant) GetInterfacePathByName(ifname string) (dbus.ObjectPath, error) {
+// Driver returns the driver an interface was last created with, or "wired" for
+// interfaces the mock did not create.
+func (m *MockSupplicant) Driver(ifname string) string {
+	return m.Options(ifname).Driver
+}
+
+// Options returns the options an interface was last created with, or the wired
+// driver alone for interfaces the mock did not create.
+func (m *MockSupplicant) Options(ifname string) dbus.InterfaceOptions {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.interfaceOptions(godbus.ObjectPath("/mock/" + ifname))
+}
+
+func (m *MockSupplicant) interfaceOptions(ifacePath godbus.ObjectPath) dbus.InterfaceOptions {
+	if opts, ok := m.options[ifacePath]; ok {
+		return opts
+	}
+	return dbus.InterfaceOptions{Driver: "wired"}
+}
+
+func (m *MockSupplicant) GetInterfacePathByName(ifname string) (godbus.ObjectPath, error) {
 	if ifname == "fail" {
 		return "", errors.New("not found")
 	}
-	return dbus.ObjectPath("/mock/" + ifname), nil
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.stopped {
+		return "", errMockStopped
+	}
+	ifacePath := godbus.ObjectPath("/mock/" + ifname)
+	if _, ok := m.options[ifacePath]; !ok {
+		return "", fmt.Errorf("%w: %s", dbus.ErrInterfaceUnknown, ifname)
+	}
+	return ifacePath, nil
+}
+
+func (m *MockSupplicant) AddNetwork(ifacePath godbus.ObjectPath, config map[string]string) (godbus.ObjectPath, error) {
+	m.beginConfigure(ifacePath)
+	if m.ConcurrentPorts > 0 {
+		m.awaitConcurrentPorts()
+	}
+	time.Sleep(m.OpDelay)
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.networks == nil {
+		m.networks = make(map[godbus.ObjectPath]map[string]string)
+	}
+	netPath := godbus.ObjectPath(fmt.Sprintf("%s/Networks/%d", ifacePath, m.nextNet))
+	m.nextNet++
+	cfg := make(map[string]string, len(config))
+	for k, v := range config {
+		cfg[k] = v
+	}
+	m.networks[netPath] = cfg
+	return netPath, nil
 }
 
-func (m *MockSupplicant) AddNetwork(_ dbus.ObjectPath, _ map[string]string) (dbus.ObjectPath, error) {
-	return dbus.ObjectPath("/mock/net"), nil
+func (m *MockSupplicant) RemoveNetwork(ifacePath, netPath godbus.ObjectPath) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.networks[netPath]; !ok || !strings.HasPrefix(string(netPath), string(ifacePath)+"/") {
+		return fmt.Errorf("%w: %s", dbus.ErrNetworkUnknown, netPath)
+	}
+	delete(m.networks, netPath)
+	return nil
 }
 
-func (m *MockSupplicant) SelectNetwork(_, _ dbus.ObjectPath) error {
+func (m *MockSupplicant) RemoveAllNetworks(ifacePath godbus.ObjectPath) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for netPath := range m.networks {
+		if strings.HasPrefix(string(netPath), string(ifacePath)+"/") {
+			delete(m.networks, netPath)
+		}
+	}
 	return nil
 }
 
-func (m *MockSupplicant) DisconnectNetwork(_ dbus.ObjectPath) error {
+func (m *MockSupplicant) ListNetworks(ifacePath godbus.ObjectPath) ([]godbus.ObjectPath, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var paths []godbus.ObjectPath
+	for netPath := range m.networks {
+		if strings.HasPrefix(string(netPath), string(ifacePath)+"/") {
+			paths = append(paths, netPath)
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool { return networkID(paths[i]) < networkID(paths[j]) })
+	return paths, nil
+}
+
+func (m *MockSupplicant) AddBlob(ifacePath godbus.ObjectPath, name string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.NoBlobs {
+		return errors.New("AddBlob failed: blobs not supported")
+	}
+	if m.blobs == nil {
+		m.blobs = make(map[godbus.ObjectPath]map[string][]byte)
+	}
+	if m.blobs[ifacePath] == nil {
+		m.blobs[ifacePath] = make(map[string][]byte)
+	}
+	if _, ok := m.blobs[ifacePath][name]; ok {
+		return fmt.Errorf("AddBlob failed: blob %s exists", name)
+	}
+	m.blobs[ifacePath][name] = append([]byte(nil), data...)
 	return nil
 }
 
+func (m *MockSupplicant) RemoveBlob(ifacePath godbus.ObjectPath, name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.blobs[ifacePath][name]; !ok {
+		return fmt.Errorf("%w: %s", dbus.ErrBlobUnknown, name)
+	}
+	delete(m.blobs[ifacePath], name)
+	return nil
+}
+
+// Blob returns the content of a blob on an interface, or nil if it does not exist.
+func (m *MockSupplicant) Blob(ifname, name string) []byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.blobs[godbus.ObjectPath("/mock/"+ifname)][name]
+}
+
+// Networks returns the configurations of the networks on an interface, in the order they were added.
+func (m *MockSupplicant) Networks(ifname string) []map[string]string {
+	paths, _ := m.ListNetworks(godbus.ObjectPath("/mock/" + ifname))
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var cfgs []map[string]string
+	for _, p := range paths {
+		cfgs = append(cfgs, m.networks[p])
+	}
+	return cfgs
+}
+
+// SelectNetwork emulates an immediate EAP exchange, which succeeds unless
+// FailAuth was set for the interface. A logged off interface only associates.
+func (m *MockSupplicant) SelectNetwork(ifacePath, netPath godbus.ObjectPath) error {
+	time.Sleep(m.OpDelay)
+	m.mu.Lock()
+	delete(m.pending, ifacePath)
+	if m.FailSelect {
+		m.mu.Unlock()
+		return errors.New("fi.w1.wpa_supplicant1.NetworkUnknown: network cannot be selected")
+	}
+	if m.logoff[ifacePath] {
+		m.mu.Unlock()
+		m.setState(ifacePath, "associated", netPath)
+		return nil
+	}
+	if m.HoldAuth {
+		if st, ok := m.states[ifacePath]; ok {
+			st.CurrentNetwork = netPath
+		}
+		m.propSub.publish(ifacePath, map[string]godbus.Variant{"CurrentNetwork": godbus.MakeVariant(netPath)})
+		m.mu.Unlock()
+		return nil
+	}
+	m.mu.Unlock()
+	m.authenticate(ifacePath, netPath)
+	return nil
+}
+
+// authenticate emulates an EAP authentication on a network, which fails if
+// FailAuth was set for the interface.
+func (m *MockSupplicant) authenticate(ifacePath, netPath godbus.ObjectPath) {
+	m.mu.Lock()
+	method := m.networks[netPath]["eap"]
+	fail := m.authFail[ifacePath] || m.rejected[ifacePath][method]
+	m.mu.Unlock()
+
+	m.publishEAP(ifacePath, "started", "")
+	m.publishEAP(ifacePath, "method", method)
+
+	if fail {
+		m.publishEAP(ifacePath, "completion", "failure")
+		m.setState(ifacePath, "disconnected", netPath)
+		return
+	}
+	m.publishEAP(ifacePath, "completion", "success")
+	m.setState(ifacePath, "completed", netPath)
+}
+
+// beginConfigure marks an interface as between AddNetwork and SelectNetwork,
+// counting an overlap if another configuration was already in progress.
+func (m *MockSupplicant) beginConfigure(ifacePath godbus.ObjectPath) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.pending == nil {
+		m.pending = make(map[godbus.ObjectPath]bool)
+	}
+	if m.pending[ifacePath] {
+		m.overlaps++
+	}
+	m.pending[ifacePath] = true
+	m.peak = max(m.peak, len(m.pending))
+}
+
+// awaitConcurrentPorts waits until ConcurrentPorts interfaces have a configuration
+// in progress, or a second has passed.
+func (m *MockSupplicant) awaitConcurrentPorts() {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		m.mu.Lock()
+		n := len(m.pending)
+		m.mu.Unlock()
+		if n >= m.ConcurrentPorts {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// Peak returns the largest number of interfaces that had a configuration in
+// progress at the same time.
+func (m *MockSupplicant) Peak() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.peak
+}
+
+// Overlaps returns how many times an operation on an interface interleaved with
+// a configuration in progress on the same interface.
+func (m *MockSupplicant) Overlaps() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.overlaps
+}
+
+// FailAuth makes subsequent authentications on an interface fail.
+func (m *MockSupplicant) FailAuth(ifname string, fail bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.authFail == nil {
+		m.authFail = make(map[godbus.ObjectPath]bool)
+	}
+	m.authFail[godbus.ObjectPath("/mock/"+ifname)] = fail
+}
+
+// FailMethod makes authentication on an interface fail for networks of one EAP
+// method (e.g. "TLS"), as with a server that rejects those credentials.
+func (m *MockSupplicant) FailMethod(ifname, method string, fail bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ifacePath := godbus.ObjectPath("/mock/" + ifname)
+	if m.rejected == nil {
+		m.rejected = make(map[godbus.ObjectPath]map[string]bool)
+	}
+	if m.rejected[ifacePath] == nil {
+		m.rejected[ifacePath] = make(map[string]bool)
+	}
+	m.rejected[ifacePath][method] = fail
+}
+
+func (m *MockSupplicant) DisconnectNetwork(ifacePath godbus.ObjectPath) error {
+	m.mu.Lock()
+	if m.pending[ifacePath] {
+		m.overlaps++
+	}
+	m.mu.Unlock()
+	time.Sleep(m.OpDelay)
+	m.setState(ifacePath, "disconnected", "/")
+	return nil
+}
+
+// Reauthenticate runs the EAP authentication of the current network again, unless
+// the interface is logged off.
+func (m *MockSupplicant) Reauthenticate(ifacePath godbus.ObjectPath) error {
+	m.mu.Lock()
+	st, ok := m.states[ifacePath]
+	if !ok || st.State == "disconnected" {
+		m.mu.Unlock()
+		return fmt.Errorf("%w: %s", dbus.ErrNotConnected, ifacePath)
+	}
+	netPath, loggedOff := st.CurrentNetwork, m.logoff[ifacePath]
+	m.mu.Unlock()
+	if !loggedOff {
+		m.authenticate(ifacePath, netPath)
+	}
+	return nil
+}
+
+// EAPLogoff leaves the interface associated but unauthenticated until EAPLogon.
+func (m *MockSupplicant) EAPLogoff(ifacePath godbus.ObjectPath) error {
+	m.mu.Lock()
+	if m.logoff == nil {
+		m.logoff = make(map[godbus.ObjectPath]bool)
+	}
+	m.logoff[ifacePath] = true
+	network := godbus.ObjectPath("/")
+	if st, ok := m.states[ifacePath]; ok {
+		network = st.CurrentNetwork
+	}
+	m.mu.Unlock()
+	m.setState(ifacePath, "associated", network)
+	return nil
+}
+
+// EAPLogon authenticates the current network of a logged off interface again.
+func (m *MockSupplicant) EAPLogon(ifacePath godbus.ObjectPath) error {
+	m.mu.Lock()
+	loggedOff := m.logoff[ifacePath]
+	delete(m.logoff, ifacePath)
+	network := godbus.ObjectPath("/")
+	if st, ok := m.states[ifacePath]; ok {
+		network = st.CurrentNetwork
+	}
+	m.mu.Unlock()
+	if loggedOff && network != "/" {
+		m.authenticate(ifacePath, network)
+	}
+	return nil
+}
+
+// LoggedOff reports whether EAPLogoff was called on an interface without a later
+// EAPLogon.
+func (m *MockSupplicant) LoggedOff(ifname string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.logoff[godbus.ObjectPath("/mock/"+ifname)]
+}
+
+func (m *MockSupplicant) GetInterfaceState(ifacePath godbus.ObjectPath) (*dbus.InterfaceState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if st, ok := m.states[ifacePath]; ok {
+		cp := *st
+		cp.Options = m.interfaceOptions(ifacePath)
+		return &cp, nil
+	}
+	return &dbus.InterfaceState{
+		Ifname:         path.Base(string(ifacePath)),
+		State:          "disconnected",
+		CurrentNetwork: "/",
+		Options:        m.interfaceOptions(ifacePath),
+	}, nil
+}
+
+// SubscribePropertiesChanged emulates PropertiesChanged signals for state changes made
+// through SelectNetwork, DisconnectNetwork and SetState.
+func (m *MockSupplicant) SubscribePropertiesChanged(ifacePath godbus.ObjectPath) (<-chan map[string]godbus.Variant, func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ch, cancel := m.propSub.subscribe(&m.mu, ifacePath)
+	return ch, cancel, nil
+}
+
+func (m *MockSupplicant) SubscribeEAP(ifacePath godbus.ObjectPath) (<-chan dbus.EAPEvent, func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ch, cancel := m.eapSub.subscribe(&m.mu, ifacePath)
+	return ch, cancel, nil
+}
+
+func (m *MockSupplicant) SubscribeNameOwnerChanged() (<-chan dbus.NameOwnerChange, func(), error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	ch, cancel := m.ownerSub.subscribe(&m.mu, mockBusPath)
+	return ch, cancel, nil
+}
+
+// GetBSS returns the access point a wireless network of the mock associated with.
+func (m *MockSupplicant) GetBSS(bssPath godbus.ObjectPath) (*dbus.BSS, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bss, ok := m.bsss[bssPath]
+	if !ok {
+		return nil, fmt.Errorf("reading BSS %s failed: unknown object", bssPath)
+	}
+	return &bss, nil
+}
+
+// EapMethods reports Methods, or every EAP method wpa_supplicant can be built with.
+func (m *MockSupplicant) EapMethods() ([]string, error) {
+	if m.Methods != nil {
+		return m.Methods, nil
+	}
+	return []string{"MD5", "TLS", "MSCHAPV2", "PEAP", "TTLS", "GTC", "OTP", "LEAP", "FAST", "PWD", "TEAP"}, nil
+}
+
 func (m *MockSupplicant) Close() {}
+
+// Restart emulates wpa_supplicant exiting and starting again: every interface
+// state and network is dropped between the two NameOwnerChanged signals.
+func (m *MockSupplicant) Restart() {
+	m.Stop()
+	m.Start()
+}
+
+// Stop emulates wpa_supplicant leaving the bus: every interface state and network
+// is dropped, and interfaces cannot be created or looked up until Start.
+func (m *MockSupplicant) Stop() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ownerSub.publish(mockBusPath, dbus.NameOwnerChange{OldOwner: ":1.1"})
+	m.stopped = true
+	m.options = nil
+	m.bsss = nil
+	m.logoff = nil
+	m.states = nil
+	m.networks = nil
+	m.blobs = nil
+	m.pending = nil
+}
+
+// Start emulates wpa_supplicant appearing on the bus.
+func (m *MockSupplicant) Start() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.stopped = false
+	m.ownerSub.publish(mockBusPath, dbus.NameOwnerChange{NewOwner: ":1.2"})
+}
+
+// SetState overrides the wpa_supplicant State property reported for an interface.
+func (m *MockSupplicant) SetState(ifname, state string) {
+	ifacePath := godbus.ObjectPath("/mock/" + ifname)
+	m.mu.Lock()
+	network := godbus.ObjectPath("/")
+	if st, ok := m.states[ifacePath]; ok {
+		network = st.CurrentNetwork
+	}
+	m.mu.Unlock()
+	m.setState(ifacePath, state, network)
+}
+
+func (m *MockSupplicant) setState(ifacePath godbus.ObjectPath, state string, network godbus.ObjectPath) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.states == nil {
+		m.states = make(map[godbus.ObjectPath]*dbus.InterfaceState)
+	}
+	// Wireless networks associate with a single access point per interface
+	bss := godbus.ObjectPath("/")
+	if cfg := m.networks[network]; cfg["ssid"] != "" && slices.Contains([]string{"associated", "completed"}, state) {
+		bss = ifacePath + "/BSSs/0"
+		freq, _ := strconv.Atoi(strings.Fields(cfg["freq_list"] + " 2412")[0])
+		if m.bsss == nil {
+			m.bsss = make(map[godbus.ObjectPath]dbus.BSS)
+		}
+		m.bsss[bss] = dbus.BSS{BSSID: "02:00:00:00:00:01", SSID: cfg["ssid"], Frequency: uint32(freq), Signal: -52}
+	}
+	m.states[ifacePath] = &dbus.InterfaceState{
+		Ifname:         path.Base(string(ifacePath)),
+		State:          state,
+		CurrentNetwork: network,
+		CurrentBSS:     bss,
+	}
+
+	changed := map[string]godbus.Variant{
+		"State":          godbus.MakeVariant(state),
+		"CurrentNetwork": godbus.MakeVariant(network),
+		"CurrentBSS":     godbus.MakeVariant(bss),
+	}
+	m.propSub.publish(ifacePath, changed)
+}
+
+// EAPSubscribers returns the number of active EAP signal subscriptions on an interface.
+func (m *MockSupplicant) EAPSubscribers(ifname string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.eapSub.subs[godbus.ObjectPath("/mock/"+ifname)])
+}
+
+// EmitEAP emulates a fi.w1.wpa_supplicant1.Interface.EAP signal on an interface.
+func (m *MockSupplicant) EmitEAP(ifname, status, parameter string) {
+	m.publishEAP(godbus.ObjectPath("/mock/"+ifname), status, parameter)
+}
+
+func (m *MockSupplicant) publishEAP(ifacePath godbus.ObjectPath, status, parameter string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.eapSub.publish(ifacePath, dbus.EAPEvent{Status: status, Parameter: parameter})
+}
+
+// networkID returns the numeric ID at the end of a mock network path.
+func networkID(netPath godbus.ObjectPath) int {
+	id, _ := strconv.Atoi(path.Base(string(netPath)))
+	return id
+}
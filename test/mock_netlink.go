@@ -0,0 +1,40 @@
+package test
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/netlink"
+)
+
+type MockLinkInfo struct {
+	mu    sync.Mutex
+	links map[string]netlink.LinkInfo
+}
+
+func (m *MockLinkInfo) LinkInfo(ifname string) (*netlink.LinkInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	info, ok := m.links[ifname]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", netlink.ErrLinkNotFound, ifname)
+	}
+	return &info, nil
+}
+
+// SetLink sets the kernel link state reported for an interface.
+func (m *MockLinkInfo) SetLink(info netlink.LinkInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.links == nil {
+		m.links = make(map[string]netlink.LinkInfo)
+	}
+	m.links[info.Name] = info
+}
+
+// RemoveLink removes the kernel link state of an interface.
+func (m *MockLinkInfo) RemoveLink(ifname string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.links, ifname)
+}
@@ -0,0 +1,115 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestPhase2Methods(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+
+	tests := []struct {
+		name   string
+		eap    pb.EapType
+		phase2 pb.Phase2Method
+		legacy string
+		want   string
+	}{
+		{"PEAP default", pb.EapType_EAP_PEAP, pb.Phase2Method_PHASE2_UNSPECIFIED, "", "auth=MSCHAPV2"},
+		{"PEAP GTC", pb.EapType_EAP_PEAP, pb.Phase2Method_PHASE2_GTC, "", "auth=GTC"},
+		{"PEAP legacy lower case", pb.EapType_EAP_PEAP, pb.Phase2Method_PHASE2_UNSPECIFIED, "mschapv2", "auth=MSCHAPV2"},
+		{"TTLS PAP", pb.EapType_EAP_TTLS, pb.Phase2Method_PHASE2_PAP, "", "auth=PAP"},
+		{"TTLS EAP-MSCHAPv2", pb.EapType_EAP_TTLS, pb.Phase2Method_PHASE2_EAP_MSCHAPV2, "", "autheap=MSCHAPV2"},
+		{"TTLS legacy autheap", pb.EapType_EAP_TTLS, pb.Phase2Method_PHASE2_UNSPECIFIED, "autheap=gtc", "autheap=GTC"},
+		{"TTLS both fields agree", pb.EapType_EAP_TTLS, pb.Phase2Method_PHASE2_CHAP, "auth=CHAP", "auth=CHAP"},
+		{"TEAP default", pb.EapType_EAP_TEAP, pb.Phase2Method_PHASE2_UNSPECIFIED, "", "auth=MSCHAPV2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+				Interface:  "eth60",
+				EapType:    tt.eap,
+				Identity:   "alice",
+				Password:   "pw",
+				Phase2:     tt.phase2,
+				Phase2Auth: tt.legacy,
+			})
+			if err != nil || !resp.Success {
+				t.Fatalf("Configure failed: %v %v", err, resp)
+			}
+			if got := m.Networks("eth60")[0]["phase2"]; got != tt.want {
+				t.Errorf("Expected phase2=%q, got %q", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPhase2InvalidArgument(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+
+	tests := []struct {
+		name  string
+		req   *pb.Dot1XConfigRequest
+		field string
+	}{
+		{"PEAP with PAP", &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PEAP, Phase2: pb.Phase2Method_PHASE2_PAP}, "phase2"},
+		{"TTLS legacy EAP-PAP", &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_TTLS, Phase2Auth: "autheap=PAP"}, "phase2_auth"},
+		{"empty legacy method", &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_PEAP, Phase2Auth: "auth="}, "phase2_auth"},
+		{"fields conflict", &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_TTLS, Phase2: pb.Phase2Method_PHASE2_PAP, Phase2Auth: "chap"}, "phase2_auth"},
+		{"inner method for MD5", &pb.Dot1XConfigRequest{EapType: pb.EapType_EAP_MD5, Phase2: pb.Phase2Method_PHASE2_MSCHAPV2}, "phase2"},
+		{"anonymous provisioning with GTC", &pb.Dot1XConfigRequest{
+			EapType:          pb.EapType_EAP_FAST,
+			Phase2:           pb.Phase2Method_PHASE2_GTC,
+			FastProvisioning: pb.FastProvisioning_FAST_PROVISIONING_UNAUTHENTICATED,
+		}, "phase2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.req.Interface, tt.req.Identity, tt.req.Password = "eth61", "alice", "pw"
+			_, err := client.ConfigureInterface(ctx, tt.req)
+			st := status.Convert(err)
+			if st.Code() != codes.InvalidArgument {
+				t.Fatalf("Expected InvalidArgument, got %v", err)
+			}
+			var violations []*errdetails.BadRequest_FieldViolation
+			for _, d := range st.Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					violations = br.FieldViolations
+				}
+			}
+			if len(violations) != 1 || violations[0].Field != tt.field {
+				t.Errorf("Expected a violation of %s, got %v", tt.field, violations)
+			}
+		})
+	}
+	if nets := m.Networks("eth61"); len(nets) != 0 {
+		t.Errorf("Expected no network for invalid requests, got %v", nets)
+	}
+
+	// ConfigureAndWatch fails the stream the same way
+	stream, err := client.ConfigureAndWatch(ctx, &pb.ConfigureAndWatchRequest{Config: &pb.Dot1XConfigRequest{
+		Interface: "eth61",
+		EapType:   pb.EapType_EAP_PEAP,
+		Identity:  "alice",
+		Password:  "pw",
+		Phase2:    pb.Phase2Method_PHASE2_CHAP,
+	}})
+	if err != nil {
+		t.Fatalf("ConfigureAndWatch error: %v", err)
+	}
+	if _, err := stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Expected InvalidArgument from the stream, got %v", err)
+	}
+}
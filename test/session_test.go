@@ -0,0 +1,153 @@
+package test
+
+import (
+	"context"
+	"testing"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestSessionControl(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	req := &pb.InterfaceRequest{Interface: "eth90"}
+
+	expectState := func(want string) {
+		t.Helper()
+		st, err := client.GetStatus(ctx, req)
+		if err != nil {
+			t.Fatalf("GetStatus error: %v", err)
+		}
+		if st.Status != want {
+			t.Errorf("Expected state %s, got %s", want, st.Status)
+		}
+	}
+
+	// Nothing to control before the interface is configured
+	re, err := client.Reauthenticate(ctx, req)
+	if err != nil {
+		t.Fatalf("Reauthenticate error: %v", err)
+	}
+	if re.Success {
+		t.Errorf("Expected reauthentication of an unmanaged interface to fail, got %v", re)
+	}
+
+	resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface: "eth90",
+		EapType:   pb.EapType_EAP_PEAP,
+		Identity:  "alice",
+		Password:  "pw",
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	nets := m.Networks("eth90")
+
+	// Reauthentication runs EAP again with the same network
+	m.FailAuth("eth90", true)
+	re, err = client.Reauthenticate(ctx, req)
+	if err != nil || !re.Success {
+		t.Fatalf("Reauthenticate failed: %v %v", err, re)
+	}
+	expectState("disconnected")
+	if got := m.Networks("eth90"); len(got) != 1 || got[0]["identity"] != nets[0]["identity"] {
+		t.Errorf("Expected the network to be kept, got %v", got)
+	}
+
+	// A disconnected interface cannot reauthenticate
+	re, err = client.Reauthenticate(ctx, req)
+	if err != nil {
+		t.Fatalf("Reauthenticate error: %v", err)
+	}
+	if re.Success || re.Message != "Interface not connected" {
+		t.Errorf("Expected reauthentication to fail while disconnected, got %v", re)
+	}
+	m.FailAuth("eth90", false)
+	m.SetState("eth90", "completed")
+
+	// Logoff holds off authentication until logon
+	off, err := client.EapolLogoff(ctx, req)
+	if err != nil || !off.Success {
+		t.Fatalf("EapolLogoff failed: %v %v", err, off)
+	}
+	if !m.LoggedOff("eth90") {
+		t.Error("Expected EAPOL-Logoff to be sent")
+	}
+	expectState("associated")
+	if re, err := client.Reauthenticate(ctx, req); err != nil || !re.Success {
+		t.Fatalf("Reauthenticate failed: %v %v", err, re)
+	}
+	expectState("associated")
+
+	on, err := client.EapolLogon(ctx, req)
+	if err != nil || !on.Success {
+		t.Fatalf("EapolLogon failed: %v %v", err, on)
+	}
+	if m.LoggedOff("eth90") {
+		t.Error("Expected EAPOL-Logon to end the logoff")
+	}
+	expectState("completed")
+
+	// Disconnect removes the network the session belongs to
+	if _, err := client.Disconnect(ctx, req); err != nil {
+		t.Fatalf("Disconnect error: %v", err)
+	}
+	off, err = client.EapolLogoff(ctx, req)
+	if err != nil {
+		t.Fatalf("EapolLogoff error: %v", err)
+	}
+	if off.Success {
+		t.Errorf("Expected logoff without a network to fail, got %v", off)
+	}
+}
+
+func TestLogoffSurvivesReapply(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	req := &pb.InterfaceRequest{Interface: "eth91"}
+	cfg := &pb.Dot1XConfigRequest{Interface: "eth91", EapType: pb.EapType_EAP_PEAP, Identity: "alice", Password: "pw"}
+
+	if resp, err := client.ConfigureInterface(ctx, cfg); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	if off, err := client.EapolLogoff(ctx, req); err != nil || !off.Success {
+		t.Fatalf("EapolLogoff failed: %v %v", err, off)
+	}
+	loggedOff := func(when string) {
+		t.Helper()
+		st, err := client.GetStatus(ctx, req)
+		if err != nil {
+			t.Fatalf("GetStatus error: %v", err)
+		}
+		if !m.LoggedOff("eth91") || st.Status != "associated" {
+			t.Errorf("Expected eth91 to stay logged off %s, got logged off %v in state %s", when, m.LoggedOff("eth91"), st.Status)
+		}
+	}
+
+	// Applying the configuration again does not log the interface on
+	if resp, err := client.ConfigureInterface(ctx, cfg); err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+	loggedOff("after reconfiguring")
+
+	// Neither does re-applying it on the interface of a restarted wpa_supplicant
+	m.Restart()
+	waitFor(t, "eth91 to be re-applied logged off", func() bool {
+		st, err := client.GetStatus(ctx, req)
+		return err == nil && st.Status == "associated" && m.LoggedOff("eth91")
+	})
+
+	if on, err := client.EapolLogon(ctx, req); err != nil || !on.Success {
+		t.Fatalf("EapolLogon failed: %v %v", err, on)
+	}
+	m.Restart()
+	waitFor(t, "eth91 to authenticate after logon", func() bool {
+		st, err := client.GetStatus(ctx, req)
+		return err == nil && st.Status == "completed"
+	})
+	if m.LoggedOff("eth91") {
+		t.Error("Expected no EAPOL-Logoff after EapolLogon")
+	}
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/core"
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+	"github.com/gavmckee80/dot1x-grpc/internal/store"
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestDesiredStateSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	dir, key := t.TempDir(), filepath.Join(t.TempDir(), "state.key")
+
+	st, err := store.OpenFileStore(dir, key)
+	if err != nil {
+		t.Fatalf("OpenFileStore error: %v", err)
+	}
+	client := newIsolatedClient(t, &MockSupplicant{}, core.WithStateStore(st))
+
+	for _, iface := range []string{"eth1", "eth2"} {
+		resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+			Interface:  iface,
+			EapType:    pb.EapType_EAP_PEAP,
+			Identity:   "svc-" + iface,
+			Password:   "s3cret-password",
+			Phase2Auth: "mschapv2",
+		})
+		if err != nil || !resp.Success {
+			t.Fatalf("Configure %s failed: %v %v", iface, err, resp)
+		}
+	}
+	if _, err := client.Disconnect(ctx, &pb.InterfaceRequest{Interface: "eth2"}); err != nil {
+		t.Fatalf("Disconnect error: %v", err)
+	}
+
+	// Secrets must not be stored in clear
+	data, err := os.ReadFile(filepath.Join(dir, "eth1.state"))
+	if err != nil {
+		t.Fatalf("Expected eth1 record: %v", err)
+	}
+	if bytes.Contains(data, []byte("s3cret-password")) {
+		t.Errorf("Record contains the plaintext password")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "eth2.state")); !os.IsNotExist(err) {
+		t.Errorf("Expected disconnected eth2 to be removed from desired state, stat: %v", err)
+	}
+
+	// Simulate a restart: a wpa_supplicant still holding eth1 with a stale network, and a new manager
+	restarted := &MockSupplicant{}
+	restarted.CreateInterface("eth1", dbus.InterfaceOptions{Driver: "wired"})
+	restarted.AddNetwork("/mock/eth1", map[string]string{"identity": "stale"})
+	reopened, err := store.OpenFileStore(dir, key)
+	if err != nil {
+		t.Fatalf("Reopen store error: %v", err)
+	}
+	manager := core.NewInterfaceManagerWithClient(restarted,
+		core.WithLinkInfoProvider(&MockLinkInfo{}), core.WithStateStore(reopened), testCredentialDir(t))
+	if err := manager.Reconcile(); err != nil {
+		t.Fatalf("Reconcile error: %v", err)
+	}
+
+	nets := restarted.Networks("eth1")
+	if len(nets) != 1 || nets[0]["identity"] != "svc-eth1" || nets[0]["password"] != "s3cret-password" {
+		t.Errorf("Expected eth1 to be restored with only its desired network, got %v", nets)
+	}
+	if len(restarted.Networks("eth2")) != 0 {
+		t.Errorf("Expected eth2 not to be restored")
+	}
+	if _, err := manager.Status("eth1"); err != nil {
+		t.Errorf("Expected eth1 to be managed after reconcile: %v", err)
+	}
+}
+
+func TestDesiredStateRejectsForeignKey(t *testing.T) {
+	dir := t.TempDir()
+	st, err := store.OpenFileStore(dir, filepath.Join(t.TempDir(), "state.key"))
+	if err != nil {
+		t.Fatalf("OpenFileStore error: %v", err)
+	}
+	if err := st.Save(&pb.Dot1XConfigRequest{Interface: "eth1", EapType: pb.EapType_EAP_PEAP, Identity: "x"}); err != nil {
+		t.Fatalf("Save error: %v", err)
+	}
+
+	// A different key must not be able to read the record
+	foreign := filepath.Join(t.TempDir(), "state.key")
+	if err := os.WriteFile(foreign, bytes.Repeat([]byte{1}, 32), 0600); err != nil {
+		t.Fatal(err)
+	}
+	other, err := store.OpenFileStore(dir, foreign)
+	if err != nil {
+		t.Fatalf("OpenFileStore error: %v", err)
+	}
+	reqs, err := other.Load()
+	if err == nil || len(reqs) != 0 {
+		t.Errorf("Expected decryption failure, got %d records, err %v", len(reqs), err)
+	}
+
+	if err := st.Save(&pb.Dot1XConfigRequest{Interface: "../escape"}); err == nil {
+		t.Errorf("Expected invalid interface name to be rejected")
+	}
+}
+
+func TestDesiredStateKeyOutsideStore(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := store.OpenFileStore(dir, filepath.Join(dir, "state.key")); err == nil {
+		t.Errorf("Expected a key inside the state directory to be rejected")
+	}
+}
+
+func TestDesiredStateRestoredWhenSupplicantStarts(t *testing.T) {
+	st, err := store.OpenFileStore(t.TempDir(), filepath.Join(t.TempDir(), "state.key"))
+	if err != nil {
+		t.Fatalf("OpenFileStore error: %v", err)
+	}
+	if err := st.Save(&pb.Dot1XConfigRequest{Interface: "eth3", EapType: pb.EapType_EAP_PEAP, Identity: "svc-eth3", Password: "pw"}); err != nil {
+		t.Fatalf("Save error: %v", err)
+	}
+
+	// The service starts before wpa_supplicant
+	m := &MockSupplicant{}
+	m.Stop()
+	manager := core.NewInterfaceManagerWithClient(m,
+		core.WithLinkInfoProvider(&MockLinkInfo{}), core.WithStateStore(st), testCredentialDir(t))
+	defer manager.Shutdown()
+	if err := manager.Reconcile(); err == nil {
+		t.Fatalf("Expected Reconcile to fail without wpa_supplicant")
+	}
+
+	m.Start()
+	waitFor(t, "eth3 to be restored", func() bool {
+		nets := m.Networks("eth3")
+		return len(nets) == 1 && nets[0]["identity"] == "svc-eth3"
+	})
+	if _, err := manager.Status("eth3"); err != nil {
+		t.Errorf("Expected eth3 to be managed once wpa_supplicant started: %v", err)
+	}
+}
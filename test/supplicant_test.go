@@ -0,0 +1,45 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/gavmckee80/dot1x-grpc/internal/dbus"
+)
+
+func TestNetworkProperties(t *testing.T) {
+	props := dbus.NetworkProperties(map[string]string{
+		"identity":          "alice",
+		"eapol_flags":       "0",
+		"macsec_policy":     "1",
+		"macsec_integ_only": "1",
+		"mka_priority":      "0",
+		"macsec_port":       "2",
+		"mka_cak":           "0102",
+		"priority":          "2",
+		"ssid":              "1234",
+		"ieee80211w":        "2",
+		"scan_ssid":         "1",
+	})
+
+	// wpa_supplicant quotes strings, so only text fields are sent as such
+	for key, want := range map[string]string{
+		"identity":          "s",
+		"eapol_flags":       "i",
+		"macsec_policy":     "i",
+		"macsec_integ_only": "i",
+		"mka_priority":      "i",
+		"macsec_port":       "i",
+		"mka_cak":           "ay",
+		"priority":          "i",
+		"ssid":              "s",
+		"ieee80211w":        "i",
+		"scan_ssid":         "i",
+	} {
+		if got := props[key].Signature().String(); got != want {
+			t.Errorf("Expected %s to be sent as %s, got %s (%v)", key, want, got, props[key])
+		}
+	}
+	if v, ok := props["macsec_policy"].Value().(int32); !ok || v != 1 {
+		t.Errorf("Expected macsec_policy 1, got %v", props["macsec_policy"])
+	}
+}
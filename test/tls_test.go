@@ -0,0 +1,260 @@
+package test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/youmark/pkcs8"
+	"software.sslmate.com/src/go-pkcs12"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+// testPKI is a throwaway CA with a client certificate it issued, all in PEM form.
+type testPKI struct {
+	CA   []byte
+	Cert []byte
+	Key  []byte
+
+	caCert *x509.Certificate
+	caKey  *ecdsa.PrivateKey
+	key    *ecdsa.PrivateKey
+}
+
+// newTestPKI creates a CA and a client certificate valid for client authentication.
+func newTestPKI(t *testing.T) *testPKI {
+	t.Helper()
+	caKey := newTestKey(t)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &caKey.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("Failed to create CA: %v", err)
+	}
+	caCert, _ := x509.ParseCertificate(der)
+
+	p := &testPKI{CA: pemEncode("CERTIFICATE", der), caCert: caCert, caKey: caKey, key: newTestKey(t)}
+	p.Cert = p.issue(t, p.key, func(*x509.Certificate) {})
+	keyDER, _ := x509.MarshalPKCS8PrivateKey(p.key)
+	p.Key = pemEncode("PRIVATE KEY", keyDER)
+	return p
+}
+
+// issue signs a client certificate for key, letting edit adjust the template.
+func (p *testPKI) issue(t *testing.T, key *ecdsa.PrivateKey, edit func(*x509.Certificate)) []byte {
+	t.Helper()
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: "device"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	edit(tmpl)
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, p.caCert, &key.PublicKey, p.caKey)
+	if err != nil {
+		t.Fatalf("Failed to issue certificate: %v", err)
+	}
+	return pemEncode("CERTIFICATE", der)
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	return key
+}
+
+func pemEncode(blockType string, der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der})
+}
+
+func TestConfigureTLSValidation(t *testing.T) {
+	ctx := context.Background()
+	client, done := newTestClient(t)
+	defer done()
+
+	pki := newTestPKI(t)
+	encrypted, err := pkcs8.MarshalPrivateKey(pki.key, []byte("hunter2"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedKey := pemEncode("ENCRYPTED PRIVATE KEY", encrypted)
+	caDER, _ := pem.Decode(pki.CA)
+	keyDER, _ := pem.Decode(pki.Key)
+
+	tests := []struct {
+		name   string
+		edit   func(*pb.Dot1XConfigRequest)
+		field  string // Expected field error, empty for success
+		reason string
+	}{
+		{"valid", func(*pb.Dot1XConfigRequest) {}, "", ""},
+		{"der", func(r *pb.Dot1XConfigRequest) {
+			r.CaCert, r.PrivateKey = caDER.Bytes, keyDER.Bytes
+		}, "", ""},
+		{"encrypted key", func(r *pb.Dot1XConfigRequest) {
+			r.PrivateKey, r.PrivateKeyPassword = encryptedKey, "hunter2"
+		}, "", ""},
+		{"missing ca", func(r *pb.Dot1XConfigRequest) { r.CaCert = nil }, "ca_cert", "is required"},
+		{"garbage ca", func(r *pb.Dot1XConfigRequest) { r.CaCert = []byte("CA CERT") }, "ca_cert", "no certificate"},
+		{"expired client cert", func(r *pb.Dot1XConfigRequest) {
+			r.ClientCert = pki.issue(t, pki.key, func(c *x509.Certificate) {
+				c.NotBefore, c.NotAfter = time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour)
+			})
+		}, "client_cert", "expired"},
+		{"server-only client cert", func(r *pb.Dot1XConfigRequest) {
+			r.ClientCert = pki.issue(t, pki.key, func(c *x509.Certificate) {
+				c.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+			})
+		}, "client_cert", "client authentication"},
+		{"mismatched key", func(r *pb.Dot1XConfigRequest) {
+			other, _ := x509.MarshalPKCS8PrivateKey(newTestKey(t))
+			r.PrivateKey = pemEncode("PRIVATE KEY", other)
+		}, "private_key", "does not match"},
+		{"missing password", func(r *pb.Dot1XConfigRequest) {
+			r.PrivateKey = encryptedKey
+		}, "private_key_password", "no password"},
+		{"wrong password", func(r *pb.Dot1XConfigRequest) {
+			r.PrivateKey, r.PrivateKeyPassword = encryptedKey, "wrong"
+		}, "private_key_password", "does not decrypt"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.Dot1XConfigRequest{
+				Interface:  "eth20",
+				EapType:    pb.EapType_EAP_TLS,
+				Identity:   "device",
+				CaCert:     pki.CA,
+				ClientCert: pki.Cert,
+				PrivateKey: pki.Key,
+			}
+			tt.edit(req)
+			resp, err := client.ConfigureInterface(ctx, req)
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+
+			if tt.field == "" {
+				if !resp.Success || len(resp.FieldErrors) != 0 {
+					t.Errorf("Expected success, got %v", resp)
+				}
+				return
+			}
+			if resp.Success || len(resp.FieldErrors) != 1 {
+				t.Fatalf("Expected a single field error, got %v", resp)
+			}
+			fe := resp.FieldErrors[0]
+			if fe.Field != tt.field || !strings.Contains(fe.Reason, tt.reason) {
+				t.Errorf("Expected %s error containing %q, got %s: %s", tt.field, tt.reason, fe.Field, fe.Reason)
+			}
+			if !strings.Contains(resp.Message, tt.field) {
+				t.Errorf("Expected message to name %s, got %q", tt.field, resp.Message)
+			}
+		})
+	}
+}
+
+func TestConfigurePKCS12(t *testing.T) {
+	ctx := context.Background()
+	client, done := newTestClient(t)
+	defer done()
+
+	pki := newTestPKI(t)
+	leaf, _ := pem.Decode(pki.Cert)
+	leafCert, _ := x509.ParseCertificate(leaf.Bytes)
+	bundle, err := pkcs12.Modern.Encode(pki.key, leafCert, []*x509.Certificate{pki.caCert}, "p12-secret")
+	if err != nil {
+		t.Fatalf("Failed to encode PKCS#12: %v", err)
+	}
+
+	expiredPEM, _ := pem.Decode(pki.issue(t, pki.key, func(c *x509.Certificate) {
+		c.NotBefore, c.NotAfter = time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour)
+	}))
+	expiredCert, _ := x509.ParseCertificate(expiredPEM.Bytes)
+	expired, err := pkcs12.Modern.Encode(pki.key, expiredCert, nil, "p12-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		edit   func(*pb.Dot1XConfigRequest)
+		field  string
+		reason string
+	}{
+		{"valid", func(*pb.Dot1XConfigRequest) {}, "", ""},
+		{"wrong password", func(r *pb.Dot1XConfigRequest) { r.Pkcs12Password = "wrong" }, "pkcs12_password", "does not decrypt"},
+		{"garbage", func(r *pb.Dot1XConfigRequest) { r.Pkcs12 = []byte("not a bundle") }, "pkcs12", "malformed"},
+		{"combined with cert", func(r *pb.Dot1XConfigRequest) { r.ClientCert = pki.Cert }, "pkcs12", "cannot be combined"},
+		{"expired identity", func(r *pb.Dot1XConfigRequest) { r.Pkcs12 = expired }, "pkcs12", "expired"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.Dot1XConfigRequest{
+				Interface:      "eth21",
+				EapType:        pb.EapType_EAP_TLS,
+				Identity:       "device",
+				CaCert:         pki.CA,
+				Pkcs12:         bundle,
+				Pkcs12Password: "p12-secret",
+			}
+			tt.edit(req)
+			resp, err := client.ConfigureInterface(ctx, req)
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+
+			if tt.field == "" {
+				if !resp.Success {
+					t.Fatalf("Expected success, got %v", resp)
+				}
+				return
+			}
+			if resp.Success || len(resp.FieldErrors) != 1 {
+				t.Fatalf("Expected a single field error, got %v", resp)
+			}
+			if fe := resp.FieldErrors[0]; fe.Field != tt.field || !strings.Contains(fe.Reason, tt.reason) {
+				t.Errorf("Expected %s error containing %q, got %s: %s", tt.field, tt.reason, fe.Field, fe.Reason)
+			}
+		})
+	}
+
+	// The bundle is unpacked into the certificate chain and key handed to wpa_supplicant
+	nets := mock.Networks("eth21")
+	if len(nets) != 1 {
+		t.Fatalf("Expected 1 network on eth21, got %d", len(nets))
+	}
+	chain := mock.Blob("eth21", strings.TrimPrefix(nets[0]["client_cert"], "blob://"))
+	if n := strings.Count(string(chain), "BEGIN CERTIFICATE"); n != 2 {
+		t.Errorf("Expected client certificate and intermediate in client_cert, got %d certificates", n)
+	}
+	key := mock.Blob("eth21", strings.TrimPrefix(nets[0]["private_key"], "blob://"))
+	if !strings.Contains(string(key), "BEGIN PRIVATE KEY") {
+		t.Errorf("Expected an unpacked private key, got %q", key)
+	}
+	if _, ok := nets[0]["private_key_passwd"]; ok {
+		t.Errorf("Expected no private key password for the unpacked key")
+	}
+}
@@ -0,0 +1,122 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestConfigureServerValidation(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	pki := newTestPKI(t)
+	caDir := t.TempDir()
+
+	resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:         "eth50",
+		EapType:           pb.EapType_EAP_PEAP,
+		Identity:          "alice",
+		Password:          "pw",
+		Phase2Auth:        "mschapv2",
+		CaCert:            pki.CA,
+		CaPath:            caDir,
+		DomainSuffixMatch: "radius.example.com",
+		DomainMatch:       "nps1.radius.example.com",
+		AltsubjectMatch:   []string{"DNS:nps1.radius.example.com", "DNS:nps2.radius.example.com"},
+		Phase1: &pb.Phase1Options{
+			PeapVersion:   pb.PeapVersion_PEAP_VERSION_0,
+			TlsMinVersion: pb.TlsVersion_TLS_VERSION_1_2,
+		},
+	})
+	if err != nil || !resp.Success {
+		t.Fatalf("Configure failed: %v %v", err, resp)
+	}
+
+	nets := m.Networks("eth50")
+	if len(nets) != 1 {
+		t.Fatalf("Expected 1 network, got %d", len(nets))
+	}
+	net := nets[0]
+	for key, want := range map[string]string{
+		"ca_path":             caDir,
+		"domain_suffix_match": "radius.example.com",
+		"domain_match":        "nps1.radius.example.com",
+		"altsubject_match":    "DNS:nps1.radius.example.com;DNS:nps2.radius.example.com",
+		"phase1":              "peapver=0 tls_disable_tlsv1_0=1 tls_disable_tlsv1_1=1 tls_disable_tlsv1_3=0",
+	} {
+		if got := net[key]; got != want {
+			t.Errorf("Expected %s=%q, got %q", key, want, got)
+		}
+	}
+	if blob := m.Blob("eth50", strings.TrimPrefix(net["ca_cert"], "blob://")); string(blob) != string(pki.CA) {
+		t.Errorf("Expected ca_cert to reference the CA certificate, got %q", net["ca_cert"])
+	}
+
+	// TLS version limits combine with the EAP-FAST provisioning flag
+	resp, _ = client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+		Interface:        "eth51",
+		EapType:          pb.EapType_EAP_FAST,
+		Identity:         "alice",
+		Password:         "pw",
+		FastProvisioning: pb.FastProvisioning_FAST_PROVISIONING_AUTHENTICATED,
+		Phase1:           &pb.Phase1Options{TlsMaxVersion: pb.TlsVersion_TLS_VERSION_1_2},
+	})
+	if !resp.Success {
+		t.Fatalf("Expected success, got %v", resp)
+	}
+	if got := m.Networks("eth51")[0]["phase1"]; got != "fast_provisioning=2 tls_disable_tlsv1_3=1" {
+		t.Errorf("Unexpected phase1 %q", got)
+	}
+}
+
+func TestServerValidationErrors(t *testing.T) {
+	ctx := context.Background()
+	client := newIsolatedClient(t, &MockSupplicant{})
+
+	tests := []struct {
+		name  string
+		edit  func(*pb.Dot1XConfigRequest)
+		field string
+	}{
+		{"garbage CA", func(r *pb.Dot1XConfigRequest) { r.CaCert = []byte("garbage") }, "ca_cert"},
+		{"inner CA without inner TLS", func(r *pb.Dot1XConfigRequest) { r.CaCert2 = []byte("garbage") }, "ca_cert2"},
+		{"relative CA path", func(r *pb.Dot1XConfigRequest) { r.CaPath = "certs" }, "ca_path"},
+		{"missing CA path", func(r *pb.Dot1XConfigRequest) { r.CaPath = "/nonexistent/certs" }, "ca_path"},
+		{"untyped altsubject", func(r *pb.Dot1XConfigRequest) { r.AltsubjectMatch = []string{"radius.example.com"} }, "altsubject_match"},
+		{"domain with space", func(r *pb.Dot1XConfigRequest) { r.DomainSuffixMatch = "example.com other.com" }, "domain_suffix_match"},
+		{"TLS range inverted", func(r *pb.Dot1XConfigRequest) {
+			r.Phase1 = &pb.Phase1Options{TlsMinVersion: pb.TlsVersion_TLS_VERSION_1_3, TlsMaxVersion: pb.TlsVersion_TLS_VERSION_1_2}
+		}, "phase1"},
+		{"PEAP version for TTLS", func(r *pb.Dot1XConfigRequest) {
+			r.EapType = pb.EapType_EAP_TTLS
+			r.Phase1 = &pb.Phase1Options{PeapVersion: pb.PeapVersion_PEAP_VERSION_1}
+		}, "phase1"},
+		{"domain match for MD5", func(r *pb.Dot1XConfigRequest) {
+			r.EapType = pb.EapType_EAP_MD5
+			r.DomainMatch = "radius.example.com"
+		}, "domain_match"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &pb.Dot1XConfigRequest{
+				Interface:  "eth52",
+				EapType:    pb.EapType_EAP_PEAP,
+				Identity:   "alice",
+				Password:   "pw",
+				Phase2Auth: "mschapv2",
+			}
+			tt.edit(req)
+			resp, err := client.ConfigureInterface(ctx, req)
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+			if resp.Success || len(resp.FieldErrors) != 1 || resp.FieldErrors[0].Field != tt.field {
+				t.Errorf("Expected a single %s error, got %v", tt.field, resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	pb "github.com/gavmckee80/dot1x-grpc/proto"
+)
+
+func TestConfigureWireless(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+	pki := newTestPKI(t)
+
+	configure := func(req *pb.Dot1XConfigRequest) map[string]string {
+		t.Helper()
+		req.Interface = "wlan0"
+		resp, err := client.ConfigureInterface(ctx, req)
+		if err != nil || !resp.Success {
+			t.Fatalf("Configure failed: %v %v", err, resp)
+		}
+		nets := m.Networks("wlan0")
+		if len(nets) != 1 {
+			t.Fatalf("Expected 1 network, got %d", len(nets))
+		}
+		return nets[0]
+	}
+	expect := func(net map[string]string, want map[string]string) {
+		t.Helper()
+		for key, w := range want {
+			if got := net[key]; got != w {
+				t.Errorf("Expected %s=%q, got %q", key, w, got)
+			}
+		}
+	}
+
+	// WPA2-Enterprise on the nl80211 driver
+	net := configure(&pb.Dot1XConfigRequest{
+		EapType:  pb.EapType_EAP_PEAP,
+		Identity: "alice",
+		Password: "pw",
+		Wireless: &pb.WirelessConfig{Ssid: "corp"},
+	})
+	expect(net, map[string]string{"ssid": "corp", "key_mgmt": "WPA-EAP", "eap": "PEAP"})
+	for _, key := range []string{"eapol_flags", "ieee80211w"} {
+		if v, ok := net[key]; ok {
+			t.Errorf("Expected no %s on a WPA2 network, got %q", key, v)
+		}
+	}
+	if d := m.Driver("wlan0"); d != "nl80211" {
+		t.Errorf("Expected the nl80211 driver, got %s", d)
+	}
+
+	st, err := client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "wlan0"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	ws := st.Wireless
+	if ws == nil || !ws.Associated || ws.Ssid != "corp" || ws.Bssid != "02:00:00:00:00:01" || ws.Frequency != 2412 {
+		t.Errorf("Expected an association with corp on 2412 MHz, got %v", ws)
+	}
+
+	// WPA3-Enterprise requires protected management frames
+	net = configure(&pb.Dot1XConfigRequest{
+		EapType:  pb.EapType_EAP_PEAP,
+		Identity: "alice",
+		Password: "pw",
+		Wireless: &pb.WirelessConfig{
+			Ssid:        "corp-wpa3",
+			KeyMgmt:     []pb.KeyManagement{pb.KeyManagement_KEY_MGMT_WPA_EAP_SHA256},
+			Frequencies: []uint32{5180, 5200},
+			ScanSsid:    true,
+		},
+	})
+	expect(net, map[string]string{
+		"key_mgmt":   "WPA-EAP-SHA256",
+		"ieee80211w": "2",
+		"freq_list":  "5180 5200",
+		"scan_ssid":  "1",
+	})
+	st, err = client.GetStatus(ctx, &pb.InterfaceRequest{Interface: "wlan0"})
+	if err != nil {
+		t.Fatalf("GetStatus error: %v", err)
+	}
+	if f := st.Wireless.GetFrequency(); f != 5180 {
+		t.Errorf("Expected the access point on 5180 MHz, got %d", f)
+	}
+
+	// Transition mode offers both and makes them optional
+	net = configure(&pb.Dot1XConfigRequest{
+		EapType:  pb.EapType_EAP_TTLS,
+		Identity: "alice",
+		Password: "pw",
+		Wireless: &pb.WirelessConfig{
+			Ssid:    "corp",
+			KeyMgmt: []pb.KeyManagement{pb.KeyManagement_KEY_MGMT_WPA_EAP, pb.KeyManagement_KEY_MGMT_WPA_EAP_SHA256},
+		},
+	})
+	expect(net, map[string]string{"key_mgmt": "WPA-EAP WPA-EAP-SHA256", "ieee80211w": "1"})
+
+	// 192-bit mode fixes the ciphers and TLS suites
+	net = configure(&pb.Dot1XConfigRequest{
+		EapType:    pb.EapType_EAP_TLS,
+		Identity:   "device",
+		CaCert:     pki.CA,
+		ClientCert: pki.Cert,
+		PrivateKey: pki.Key,
+		Wireless: &pb.WirelessConfig{
+			Ssid:    "corp-192",
+			KeyMgmt: []pb.KeyManagement{pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192},
+		},
+	})
+	expect(net, map[string]string{
+		"key_mgmt":   "WPA-EAP-SUITE-B-192",
+		"ieee80211w": "2",
+		"pairwise":   "GCMP-256",
+		"group":      "GCMP-256",
+		"group_mgmt": "BIP-GMAC-256",
+	})
+	if !strings.Contains(net["phase1"], "tls_suiteb=1") {
+		t.Errorf("Expected tls_suiteb=1 in phase1, got %q", net["phase1"])
+	}
+}
+
+func TestWirelessValidation(t *testing.T) {
+	ctx := context.Background()
+	m := &MockSupplicant{}
+	client := newIsolatedClient(t, m)
+
+	suiteB := []pb.KeyManagement{pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192}
+	tests := []struct {
+		name     string
+		eapType  pb.EapType
+		wireless *pb.WirelessConfig
+		macsec   *pb.MacsecConfig
+		fields   []string
+	}{
+		{
+			name:     "missing SSID",
+			eapType:  pb.EapType_EAP_PEAP,
+			wireless: &pb.WirelessConfig{},
+			fields:   []string{"wireless.ssid"},
+		},
+		{
+			name:     "method without keys",
+			eapType:  pb.EapType_EAP_MD5,
+			wireless: &pb.WirelessConfig{Ssid: "corp"},
+			fields:   []string{"eap_type"},
+		},
+		{
+			name:     "192-bit mode without EAP-TLS",
+			eapType:  pb.EapType_EAP_PEAP,
+			wireless: &pb.WirelessConfig{Ssid: "corp", KeyMgmt: suiteB},
+			fields:   []string{"eap_type"},
+		},
+		{
+			name:    "192-bit mode mixed with WPA2",
+			eapType: pb.EapType_EAP_PEAP,
+			wireless: &pb.WirelessConfig{Ssid: "corp", KeyMgmt: []pb.KeyManagement{
+				pb.KeyManagement_KEY_MGMT_WPA_EAP, pb.KeyManagement_KEY_MGMT_WPA_EAP_SUITE_B_192,
+			}},
+			fields: []string{"wireless.key_mgmt"},
+		},
+		{
+			name:    "WPA3 with optional PMF",
+			eapType: pb.EapType_EAP_PEAP,
+			wireless: &pb.WirelessConfig{
+				Ssid:    "corp",
+				KeyMgmt: []pb.KeyManagement{pb.KeyManagement_KEY_MGMT_WPA_EAP_SHA256},
+				Pmf:     pb.PmfMode_PMF_OPTIONAL,
+			},
+			fields: []string{"wireless.pmf"},
+		},
+		{
+			name:     "frequency outside the bands",
+			eapType:  pb.EapType_EAP_PEAP,
+			wireless: &pb.WirelessConfig{Ssid: "corp", Frequencies: []uint32{2412, 1000}},
+			fields:   []string{"wireless.frequencies"},
+		},
+		{
+			name:     "MACsec",
+			eapType:  pb.EapType_EAP_PEAP,
+			wireless: &pb.WirelessConfig{Ssid: "corp"},
+			macsec:   &pb.MacsecConfig{Mode: pb.MacsecMode_MACSEC_MODE_EAP},
+			fields:   []string{"macsec.mode"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := client.ConfigureInterface(ctx, &pb.Dot1XConfigRequest{
+				Interface: "wlan1",
+				EapType:   tt.eapType,
+				Identity:  "alice",
+				Password:  "pw",
+				Wireless:  tt.wireless,
+				Macsec:    tt.macsec,
+			})
+			if err != nil {
+				t.Fatalf("ConfigureInterface error: %v", err)
+			}
+			if resp.Success || len(resp.FieldErrors) != len(tt.fields) {
+				t.Fatalf("Expected errors on %v, got %v", tt.fields, resp)
+			}
+			for i, fe := range resp.FieldErrors {
+				if fe.Field != tt.fields[i] {
+					t.Errorf("Expected error on %s, got %s: %s", tt.fields[i], fe.Field, fe.Reason)
+				}
+			}
+		})
+	}
+	if len(m.Created) != 0 {
+		t.Errorf("Expected no interface to be created for invalid settings, got %v", m.Created)
+	}
+}